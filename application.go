@@ -0,0 +1,176 @@
+package core
+
+import (
+	"codnect.io/procyon-core/module"
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/conversion"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// Run function bootstraps and runs the application.
+// If no argument is given, the command line arguments are used instead. Run uses the core module,
+// prepares the environment with the arguments and the environment variables, starts the
+// application context, invokes the command line runners and starts the servers.
+// If any server or lifecycle component is running, it blocks until the given context is done or a SIGINT/SIGTERM
+// signal is received, and then stops the application context gracefully.
+func Run(ctx context.Context, args ...string) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	module.Use[Module]()
+
+	arguments, err := runtime.ParseArguments(args)
+	if err != nil {
+		return err
+	}
+
+	appContext := NewDefaultContext(ctx)
+	err = prepareContext(appContext, arguments)
+	if err != nil {
+		return err
+	}
+
+	err = appContext.Start()
+	if err != nil {
+		return err
+	}
+
+	err = invokeRunners(appContext, arguments)
+	if err != nil {
+		return errors.Join(err, appContext.Stop())
+	}
+
+	started, err := startServers(appContext)
+	if err != nil {
+		return errors.Join(err, appContext.Stop())
+	}
+
+	running, err := hasRunningLifecycles(appContext)
+	if err != nil {
+		return errors.Join(err, appContext.Stop())
+	}
+
+	if started != 0 || running {
+		<-ctx.Done()
+	}
+
+	return appContext.Stop()
+}
+
+// prepareContext function adds the arguments, the environment variables, the inline application JSON and
+// the random values as property sources to the environment of the given context. The config sources are added
+// before the random source later, so they have lower precedence than the inline application JSON.
+// The random source is seeded by the 'procyon.random.seed' property if it is given.
+// It also registers the arguments as a singleton.
+func prepareContext(ctx *DefaultContext, arguments *runtime.Arguments) error {
+	sources := ctx.Environment().PropertySources()
+	sources.AddLast(runtime.NewArgumentsSource(arguments))
+	sources.AddLast(runtime.NewEnvironmentSource())
+
+	jsonSource, err := runtime.NewApplicationJsonSource(arguments)
+	if err != nil {
+		return err
+	}
+
+	if jsonSource != nil {
+		sources.AddLast(jsonSource)
+	}
+
+	randomSource, err := newRandomSource(ctx.Environment())
+	if err != nil {
+		return err
+	}
+
+	sources.AddLast(randomSource)
+
+	return ctx.Container().Singletons().Register("procyonArguments", arguments)
+}
+
+// newRandomSource function creates the random source of the given environment. If the 'procyon.random.seed'
+// property is given, the source is seeded by it, so that the random values are reproducible.
+func newRandomSource(environment runtime.Environment) (*property.RandomSource, error) {
+	resolver := environment.PropertyResolver()
+
+	value, ok := resolver.Property(property.RandomSeedProperty)
+	if !ok {
+		return property.NewRandomSource(), nil
+	}
+
+	seed, err := conversion.Convert[uint64](property.ConversionServiceOf(resolver), value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid random seed '%v': %w", value, err)
+	}
+
+	return property.NewRandomSourceWithSeed(seed), nil
+}
+
+// invokeRunners function invokes the command line runners in the container with the given arguments.
+func invokeRunners(ctx *DefaultContext, arguments *runtime.Arguments) error {
+	runners, err := listObjects[runtime.CommandLineRunner](ctx, ctx.Container())
+	if err != nil {
+		return err
+	}
+
+	for _, runner := range runners {
+		err = runner.Run(ctx, arguments)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startServers function starts the servers in the container, registers them to be stopped on shutdown
+// and returns the number of the started servers. The servers which are also runtime.Lifecycle components
+// are skipped since they are started by the context.
+func startServers(ctx *DefaultContext) (int, error) {
+	servers, err := listObjects[runtime.Server](ctx, ctx.Container())
+	if err != nil {
+		return 0, err
+	}
+
+	started := 0
+	for _, server := range servers {
+		if _, ok := server.(runtime.Lifecycle); ok {
+			continue
+		}
+
+		err = server.Start(ctx)
+		if err != nil {
+			return started, err
+		}
+
+		started++
+		ctx.shutdown.register(fmt.Sprintf("%T", server), server.Stop)
+	}
+
+	return started, nil
+}
+
+// hasRunningLifecycles function checks if any runtime.Lifecycle component in the container is running.
+func hasRunningLifecycles(ctx *DefaultContext) (bool, error) {
+	lifecycles, err := listObjects[runtime.Lifecycle](ctx, ctx.Container())
+	if err != nil {
+		return false, err
+	}
+
+	for _, lifecycle := range lifecycles {
+		if lifecycle.IsRunning() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
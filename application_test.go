@@ -0,0 +1,199 @@
+package core
+
+import (
+	"codnect.io/procyon-core/component"
+	"codnect.io/procyon-core/component/condition"
+	"codnect.io/procyon-core/module"
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	runTestComponentsOnce sync.Once
+	runTestRunnerArgs     = make(chan *runtime.Arguments, 1)
+	runTestServerEvents   = make(chan string, 2)
+)
+
+type anyRunTestRunner struct {
+}
+
+func (r *anyRunTestRunner) Run(ctx context.Context, args *runtime.Arguments) error {
+	runTestRunnerArgs <- args
+	return nil
+}
+
+type anyRunTestServer struct {
+}
+
+func (s *anyRunTestServer) Start(ctx context.Context) error {
+	runTestServerEvents <- "started"
+	return nil
+}
+
+func (s *anyRunTestServer) Stop(ctx context.Context) error {
+	runTestServerEvents <- "stopped"
+	return nil
+}
+
+func (s *anyRunTestServer) Port() int {
+	return 8080
+}
+
+// registerRunTestComponents function registers the components used by the Run tests. They are loaded only if
+// their properties are given, so that they do not affect the other contexts created in the tests.
+func registerRunTestComponents() {
+	runTestComponentsOnce.Do(func() {
+		component.Register(func() *anyRunTestRunner {
+			return &anyRunTestRunner{}
+		}, component.WithName("anyRunTestRunner"), component.WithCondition(condition.OnProperty("procyon.test.runner")))
+
+		component.Register(func() *anyRunTestServer {
+			return &anyRunTestServer{}
+		}, component.WithName("anyRunTestServer"), component.WithCondition(condition.OnProperty("procyon.test.server")))
+	})
+}
+
+func runAsync(ctx context.Context, args ...string) chan error {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- Run(ctx, args...)
+	}()
+
+	return done
+}
+
+func TestRun_ShouldReturnErrorIfContextIsNil(t *testing.T) {
+	assert.EqualError(t, Run(nil), "nil context")
+}
+
+func TestRun_ShouldInvokeRunnersWithParsedArgumentsAndReturnIfNothingIsRunning(t *testing.T) {
+	registerRunTestComponents()
+
+	select {
+	case err := <-runAsync(context.Background(), "--procyon.test.runner=true", "anyArg"):
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run blocks although no server or lifecycle component is running")
+	}
+
+	select {
+	case args := <-runTestRunnerArgs:
+		assert.Equal(t, []string{"true"}, args.OptionValues("procyon.test.runner"))
+		assert.Contains(t, args.NonOptionArgs(), "anyArg")
+	default:
+		t.Fatal("runner is not invoked")
+	}
+}
+
+func TestRun_ShouldLoadComponentsWhoseConditionsMatchPropertiesInConfigFiles(t *testing.T) {
+	registerRunTestComponents()
+
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", "procyon.test.runner: true\n")
+
+	select {
+	case err := <-runAsync(context.Background(), "--procyon.config.location="+dir):
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run blocks although no server or lifecycle component is running")
+	}
+
+	select {
+	case <-runTestRunnerArgs:
+	default:
+		t.Fatal("runner enabled in the config file is not invoked")
+	}
+}
+
+func TestRun_ShouldStartServersAndStopThemWhenContextIsDone(t *testing.T) {
+	registerRunTestComponents()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := runAsync(ctx, "--procyon.test.server=true")
+
+	select {
+	case started := <-runTestServerEvents:
+		assert.Equal(t, "started", started)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server is not started")
+	}
+
+	select {
+	case <-done:
+		t.Fatal("Run returns although the server is running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run does not return when the context is done")
+	}
+
+	assert.Equal(t, "stopped", <-runTestServerEvents)
+}
+
+func startPreparedContext(t *testing.T, args ...string) *DefaultContext {
+	module.Use[Module]()
+
+	arguments, err := runtime.ParseArguments(args)
+	assert.Nil(t, err)
+
+	ctx := NewDefaultContext(context.Background())
+	assert.Nil(t, prepareContext(ctx, arguments))
+	assert.Nil(t, ctx.Start())
+	return ctx
+}
+
+func resolveWithRandomSeed(t *testing.T, seed string, text string) []string {
+	ctx := startPreparedContext(t, "--"+property.RandomSeedProperty+"="+seed)
+	defer ctx.Stop()
+
+	resolver := ctx.Environment().PropertyResolver()
+	return []string{resolver.ResolvePlaceholders(text), resolver.ResolvePlaceholders(text)}
+}
+
+func TestPrepareContext_ShouldSeedRandomPlaceholdersByRandomSeedProperty(t *testing.T) {
+	values := resolveWithRandomSeed(t, "1", "${random.int(8000,9000)}")
+
+	source := property.NewRandomSourceWithSeed(1)
+	first, _ := source.Property("random.int(8000,9000)")
+	second, _ := source.Property("random.int(8000,9000)")
+	assert.Equal(t, []string{fmt.Sprint(first), fmt.Sprint(second)}, values)
+
+	assert.Equal(t, values, resolveWithRandomSeed(t, "1", "${random.int(8000,9000)}"))
+}
+
+func TestPrepareContext_ShouldResolveRandomPlaceholdersOfDifferentPropertiesToDifferentValues(t *testing.T) {
+	ctx := startPreparedContext(t, "--procyon.test.a=${random.uuid}", "--procyon.test.b=${random.uuid}")
+	defer ctx.Stop()
+
+	resolver := ctx.Environment().PropertyResolver()
+	a, ok := resolver.Property("procyon.test.a")
+	assert.True(t, ok)
+	b, ok := resolver.Property("procyon.test.b")
+	assert.True(t, ok)
+
+	assert.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", a)
+	assert.NotEqual(t, a, b)
+}
+
+func TestPrepareContext_ShouldReturnErrorIfRandomSeedIsInvalid(t *testing.T) {
+	arguments, err := runtime.ParseArguments([]string{"--" + property.RandomSeedProperty + "=anySeed"})
+	assert.Nil(t, err)
+
+	err = prepareContext(NewDefaultContext(context.Background()), arguments)
+	assert.ErrorContains(t, err, "invalid random seed 'anySeed'")
+}
@@ -3,6 +3,9 @@ package condition
 import (
 	"codnect.io/procyon-core/component/filter"
 	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/conversion"
+	"codnect.io/procyon-core/runtime/property"
+	"reflect"
 )
 
 // OnPropertyCondition struct represents a condition that checks if a specific property has a specific value
@@ -35,7 +38,9 @@ func (c *OnPropertyCondition) MatchIfMissing(matchIfMissing bool) *OnPropertyCon
 // MatchesCondition method checks if the property has the specified value.
 // If the property is missing, it returns the value of MatchIfMissing.
 // If the property has the specified value, it returns true.
-// If the property is a boolean type and the specified value is nil, it returns true if the value is not false.
+// The property value is converted to the type of the specified value before they are compared,
+// so that a property value such as "8080" matches the value 8080.
+// If the specified value is nil, it returns true unless the property value is converted to false.
 func (c *OnPropertyCondition) MatchesCondition(ctx Context) bool {
 	container := ctx.Container()
 	if container == nil {
@@ -47,23 +52,22 @@ func (c *OnPropertyCondition) MatchesCondition(ctx Context) bool {
 		return false
 	}
 
-	environment := result.(runtime.Environment)
-	property, exists := environment.PropertyResolver().Property(c.name)
+	resolver := result.(runtime.Environment).PropertyResolver()
+	value, exists := resolver.Property(c.name)
 
 	if !exists {
 		return c.matchIfMissing
 	}
 
 	if c.value == nil {
-		switch val := property.(type) {
-		case bool:
-			return val != false
-		case string:
-			return val != "false"
-		default:
-			return true
-		}
+		enabled, convertErr := conversion.Convert[bool](property.ConversionServiceOf(resolver), value)
+		return convertErr != nil || enabled
 	}
 
-	return property == c.value
+	converted, err := property.ConversionServiceOf(resolver).Convert(value, reflect.TypeOf(c.value))
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(converted, c.value)
 }
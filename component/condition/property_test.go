@@ -75,3 +75,19 @@ func TestOnPropertyCondition_MatchesConditionShouldReturnFalseIfEnvironmentObjec
 	conditionContext := NewContext(context.Background(), objectContainer)
 	assert.False(t, onPropertyCondition.MatchesCondition(conditionContext))
 }
+
+func TestOnPropertyCondition_MatchesConditionShouldConvertPropertyValueToTypeOfGivenValue(t *testing.T) {
+	onPropertyCondition := OnProperty("procyon.server.port").HavingValue(8080)
+	objectContainer := container.New()
+
+	anyPropertySource := property.NewMapSource("anyPropertySource", map[string]interface{}{
+		"procyon.server.port": "8080",
+	})
+
+	environment := runtime.NewDefaultEnvironment()
+	environment.PropertySources().AddLast(anyPropertySource)
+	objectContainer.Singletons().Register("environment", environment)
+
+	conditionContext := NewContext(context.Background(), objectContainer)
+	assert.True(t, onPropertyCondition.MatchesCondition(conditionContext))
+}
@@ -22,6 +22,7 @@ type Container interface {
 	Scopes() ScopeRegistry
 	AddObjectProcessor(processor ObjectProcessor) error
 	ObjectProcessorCount() int
+	Close(ctx context.Context) error
 }
 
 type defaultContainer struct {
@@ -33,6 +34,11 @@ type defaultContainer struct {
 	typesOfProcessor map[string]struct{}
 	postProcessorMu  sync.RWMutex
 
+	// scopedObjects is a map that stores the names of the objects created in custom scopes.
+	// The key is the name of the scope and the value is the names of the objects in creation order.
+	scopedObjects   map[string][]string
+	scopedObjectsMu sync.Mutex
+
 	running bool
 	mu      sync.RWMutex
 }
@@ -45,6 +51,7 @@ func New() Container {
 		scopes:           newSimpleScopeRegistry(),
 		processors:       make([]ObjectProcessor, 0),
 		typesOfProcessor: map[string]struct{}{},
+		scopedObjects:    map[string][]string{},
 	}
 }
 
@@ -116,7 +123,15 @@ func (c *defaultContainer) GetObject(ctx context.Context, filters ...filter.Filt
 		}
 
 		defer scopeHolder.removeFromPreparation(objectName)
-		return c.createObject(ctx, definition, nil)
+
+		var object any
+		object, err = c.createObject(ctx, definition, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		c.addScopedObject(definition.Scope(), objectName)
+		return object, nil
 	})
 }
 
@@ -183,6 +198,10 @@ func (c *defaultContainer) AddObjectProcessor(processor ObjectProcessor) error {
 	c.postProcessorMu.Lock()
 
 	typ := reflect.TypeOf(processor)
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
 	typeName := fmt.Sprintf("%s.%s", typ.PkgPath(), typ.Name())
 
 	if _, ok := c.typesOfProcessor[typeName]; ok {
@@ -201,6 +220,94 @@ func (c *defaultContainer) ObjectProcessorCount() int {
 	return len(c.processors)
 }
 
+// Close method destroys the objects created in custom scopes and the singleton objects created from definitions.
+// Scoped objects are removed from their scopes, singleton objects are removed from the registry in
+// reverse registration order, so that an object is destroyed before its dependencies.
+// The singleton objects registered directly to the registry are not created by the container,
+// so they are neither destroyed nor removed.
+// Objects implementing the Destruction interface are destroyed, and all errors are aggregated.
+func (c *defaultContainer) Close(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("nil context")
+	}
+
+	err := c.destroyScopedObjects(ctx)
+
+	singletonNames := c.singletons.Names()
+	for index := len(singletonNames) - 1; index >= 0; index-- {
+		name := singletonNames[index]
+		if !c.definitions.Contains(name) {
+			continue
+		}
+
+		object, ok := c.singletons.FindFirst(filter.ByName(name))
+
+		if !ok || c.singletons.Remove(name) != nil {
+			continue
+		}
+
+		err = errors.Join(err, destroyObject(ctx, name, object))
+	}
+
+	return err
+}
+
+// destroyScopedObjects method removes the objects created in custom scopes from their scopes
+// in reverse creation order and destroys them.
+func (c *defaultContainer) destroyScopedObjects(ctx context.Context) error {
+	c.scopedObjectsMu.Lock()
+	scopedObjects := c.scopedObjects
+	c.scopedObjects = map[string][]string{}
+	c.scopedObjectsMu.Unlock()
+
+	var err error
+	for scopeName, names := range scopedObjects {
+		scope, scopeErr := c.scopes.Find(scopeName)
+		if scopeErr != nil {
+			err = errors.Join(err, scopeErr)
+			continue
+		}
+
+		for index := len(names) - 1; index >= 0; index-- {
+			object, removeErr := scope.RemoveObject(ctx, names[index])
+
+			if errors.Is(removeErr, ErrObjectNotFound) {
+				continue
+			} else if removeErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to remove object '%s' from scope '%s': %w", names[index], scopeName, removeErr))
+				continue
+			}
+
+			err = errors.Join(err, destroyObject(ctx, names[index], object))
+		}
+	}
+
+	return err
+}
+
+// addScopedObject method records the name of an object created in the given scope.
+func (c *defaultContainer) addScopedObject(scopeName string, name string) {
+	defer c.scopedObjectsMu.Unlock()
+	c.scopedObjectsMu.Lock()
+
+	if !slices.Contains(c.scopedObjects[scopeName], name) {
+		c.scopedObjects[scopeName] = append(c.scopedObjects[scopeName], name)
+	}
+}
+
+// destroyObject function destroys the given object if it implements the Destruction interface.
+func destroyObject(ctx context.Context, name string, object any) error {
+	if destruction, ok := object.(Destruction); ok {
+		err := destruction.DoDestroy(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to destroy object '%s': %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 // createObject method creates an object based on a definition and arguments.
 func (c *defaultContainer) createObject(ctx context.Context, definition *Definition, args []any) (object any, err error) {
 	if ctx == nil {
@@ -329,7 +436,7 @@ func (c *defaultContainer) initialize(ctx context.Context, object any) (any, err
 		return nil, err
 	}
 
-	if initialization, ok := object.(Initialization); ok {
+	if initialization, ok := result.(Initialization); ok {
 		err = initialization.DoInit(ctx)
 
 		if err != nil {
@@ -337,7 +444,7 @@ func (c *defaultContainer) initialize(ctx context.Context, object any) (any, err
 		}
 	}
 
-	result, err = c.applyProcessorsAfterInit(ctx, object)
+	result, err = c.applyProcessorsAfterInit(ctx, result)
 	if err != nil {
 		return nil, err
 	}
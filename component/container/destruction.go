@@ -0,0 +1,9 @@
+package container
+
+import "context"
+
+// Destruction interface is implemented by the objects which release their resources
+// when they are removed from the container.
+type Destruction interface {
+	DoDestroy(ctx context.Context) error
+}
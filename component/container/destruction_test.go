@@ -0,0 +1,113 @@
+package container
+
+import (
+	"codnect.io/procyon-core/component/filter"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var destroyedObjects []string
+
+type AnyRepository struct {
+}
+
+func (r *AnyRepository) DoDestroy(ctx context.Context) error {
+	destroyedObjects = append(destroyedObjects, "anyRepository")
+	return nil
+}
+
+type AnyService struct {
+	repository *AnyRepository
+}
+
+func (s *AnyService) DoDestroy(ctx context.Context) error {
+	destroyedObjects = append(destroyedObjects, "anyService")
+	return errors.New("anyError")
+}
+
+type anyScope struct {
+	objects map[string]any
+}
+
+func (s *anyScope) GetObject(ctx context.Context, name string, provider ObjectProviderFunc) (any, error) {
+	if object, ok := s.objects[name]; ok {
+		return object, nil
+	}
+
+	object, err := provider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.objects[name] = object
+	return object, nil
+}
+
+func (s *anyScope) RemoveObject(ctx context.Context, name string) (any, error) {
+	object, ok := s.objects[name]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+
+	delete(s.objects, name)
+	return object, nil
+}
+
+func TestObjectContainer_CloseShouldDestroySingletonObjectsInReverseDependencyOrder(t *testing.T) {
+	destroyedObjects = make([]string, 0)
+	objectContainer := New()
+
+	serviceDefinition, _ := MakeDefinition(func(repository *AnyRepository) *AnyService {
+		return &AnyService{repository: repository}
+	})
+	repositoryDefinition, _ := MakeDefinition(func() *AnyRepository {
+		return &AnyRepository{}
+	})
+
+	assert.Nil(t, objectContainer.Definitions().Register(serviceDefinition))
+	assert.Nil(t, objectContainer.Definitions().Register(repositoryDefinition))
+
+	_, err := objectContainer.GetObject(context.Background(), filter.ByName("anyService"))
+	assert.Nil(t, err)
+
+	err = objectContainer.Close(context.Background())
+	assert.EqualError(t, err, "failed to destroy object 'anyService': anyError")
+	assert.Equal(t, []string{"anyService", "anyRepository"}, destroyedObjects)
+	assert.Equal(t, 0, objectContainer.Singletons().Count())
+}
+
+func TestObjectContainer_CloseShouldRemoveAndDestroyScopedObjects(t *testing.T) {
+	destroyedObjects = make([]string, 0)
+	objectContainer := New()
+
+	scope := &anyScope{objects: map[string]any{}}
+	assert.Nil(t, objectContainer.Scopes().Register("anyScope", scope))
+
+	repositoryDefinition, _ := MakeDefinition(func() *AnyRepository {
+		return &AnyRepository{}
+	}, Scoped("anyScope"))
+	assert.Nil(t, objectContainer.Definitions().Register(repositoryDefinition))
+
+	_, err := objectContainer.GetObject(context.Background(), filter.ByName("anyRepository"))
+	assert.Nil(t, err)
+	assert.Len(t, scope.objects, 1)
+
+	err = objectContainer.Close(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"anyRepository"}, destroyedObjects)
+	assert.Len(t, scope.objects, 0)
+}
+
+func TestObjectContainer_CloseShouldNotDestroySingletonObjectsRegisteredDirectly(t *testing.T) {
+	destroyedObjects = make([]string, 0)
+	objectContainer := New()
+
+	assert.Nil(t, objectContainer.Singletons().Register("anyRepository", &AnyRepository{}))
+
+	err := objectContainer.Close(context.Background())
+	assert.Nil(t, err)
+	assert.Empty(t, destroyedObjects)
+	assert.True(t, objectContainer.Singletons().Contains("anyRepository"))
+}
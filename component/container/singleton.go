@@ -4,6 +4,7 @@ import (
 	"codnect.io/procyon-core/component/filter"
 	"context"
 	"reflect"
+	"slices"
 	"sync"
 )
 
@@ -24,7 +25,7 @@ type SingletonRegistry interface {
 	OrElseCreate(name string, provider ObjectProviderFunc) (any, error)
 	// Contains checks if a singleton object with the provided name exists
 	Contains(name string) bool
-	// Names returns the names of all singleton objects
+	// Names returns the names of all singleton objects in registration order
 	Names() []string
 	// Count returns the number of singleton objects
 	Count() int
@@ -36,7 +37,9 @@ type singletonObjectRegistry struct {
 	singletonObjects map[string]any
 	// typesOfSingletonObjects is a map that stores the types of singleton objects
 	typesOfSingletonObjects map[string]reflect.Type
-	objectCreationContext   context.Context
+	// singletonNames is a slice that stores the names of singleton objects in registration order
+	singletonNames        []string
+	objectCreationContext context.Context
 	// muSingletonObjects is a mutex that protects the singletonObjects map
 	muSingletonObjects sync.RWMutex
 }
@@ -46,6 +49,7 @@ func newSingletonObjectRegistry() *singletonObjectRegistry {
 	return &singletonObjectRegistry{
 		singletonObjects:        make(map[string]any),
 		typesOfSingletonObjects: make(map[string]reflect.Type),
+		singletonNames:          make([]string, 0),
 		objectCreationContext:   withObjectCreationState(context.Background()),
 	}
 }
@@ -61,6 +65,7 @@ func (r *singletonObjectRegistry) Register(name string, object any) error {
 
 	r.singletonObjects[name] = object
 	r.typesOfSingletonObjects[name] = reflect.TypeOf(object)
+	r.singletonNames = append(r.singletonNames, name)
 	return nil
 }
 
@@ -75,6 +80,9 @@ func (r *singletonObjectRegistry) Remove(name string) error {
 
 	delete(r.singletonObjects, name)
 	delete(r.typesOfSingletonObjects, name)
+	r.singletonNames = slices.DeleteFunc(r.singletonNames, func(singletonName string) bool {
+		return singletonName == name
+	})
 	return nil
 }
 
@@ -156,8 +164,12 @@ func (r *singletonObjectRegistry) OrElseCreate(name string, provider ObjectProvi
 		return nil, err
 	}
 
+	defer r.muSingletonObjects.Unlock()
+	r.muSingletonObjects.Lock()
+
 	r.singletonObjects[name] = object
 	r.typesOfSingletonObjects[name] = reflect.TypeOf(object)
+	r.singletonNames = append(r.singletonNames, name)
 
 	return object, nil
 }
@@ -171,16 +183,13 @@ func (r *singletonObjectRegistry) Contains(name string) bool {
 	return exists
 }
 
-// Names returns the names of all singleton objects
+// Names returns the names of all singleton objects in registration order
 func (r *singletonObjectRegistry) Names() []string {
 	defer r.muSingletonObjects.Unlock()
 	r.muSingletonObjects.Lock()
 
-	names := make([]string, 0)
-	for name := range r.singletonObjects {
-		names = append(names, name)
-	}
-
+	names := make([]string, len(r.singletonNames))
+	copy(names, r.singletonNames)
 	return names
 }
 
@@ -5,12 +5,26 @@ import (
 	"codnect.io/procyon-core/runtime/config"
 	"codnect.io/procyon-core/runtime/property"
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
 	"strings"
+	"sync"
 )
 
+// configLocations struct holds the locations and the names of the config files.
+type configLocations struct {
+	locations []string
+	names     []string
+}
+
 type configContextConfigurer struct {
-	loaders  []property.SourceLoader
-	importer *config.Importer
+	loaders   []property.SourceLoader
+	importer  *config.Importer
+	locations *configLocations
+	loaded    []*config.Config
+	mu        sync.Mutex
 }
 
 func newConfigContextConfigurer(loaders []property.SourceLoader, importer *config.Importer) *configContextConfigurer {
@@ -21,30 +35,63 @@ func newConfigContextConfigurer(loaders []property.SourceLoader, importer *confi
 }
 
 func (c *configContextConfigurer) ConfigureContext(ctx runtime.Context) error {
-	return c.importConfig(ctx.Environment())
+	err := c.importConfig(ctx.Environment())
+	if err != nil {
+		return err
+	}
+
+	return c.logEnvironment(ctx)
+}
+
+// logEnvironment method logs the report of the environment of the given context in YAML form
+// if the debug logging is enabled. The sensitive values are masked in the report.
+func (c *configContextConfigurer) logEnvironment(ctx runtime.Context) error {
+	if !log.IsDebugEnabled() {
+		return nil
+	}
+
+	sanitizer, err := runtime.NewEnvironmentSanitizer(ctx.Environment())
+	if err != nil {
+		return err
+	}
+
+	report, err := runtime.NewEnvironmentReport(ctx.Environment(), sanitizer).YAML()
+	if err != nil {
+		return err
+	}
+
+	log.D(ctx, "Environment:\n{}", string(report))
+	return nil
 }
 
 func (c *configContextConfigurer) importConfig(environment runtime.Environment) error {
-	defaultConfigs, err := c.importer.Import(context.Background(), "resources", environment.DefaultProfiles())
+	err := c.importDotenv(environment, nil)
+	if err != nil {
+		return err
+	}
 
+	locations, err := c.configLocations(environment)
 	if err != nil {
 		return err
 	}
 
-	sources := property.NewSources()
+	c.mu.Lock()
+	c.locations = locations
+	c.mu.Unlock()
 
-	for _, defaultConfig := range defaultConfigs {
-		sources.AddLast(defaultConfig.PropertySource())
+	defaultConfigs, err := c.importConfigs(locations, environment.DefaultProfiles())
+	if err != nil {
+		return err
 	}
 
+	sources := property.NewSources()
 	activeProfiles := environment.ActiveProfiles()
 
 	if len(activeProfiles) == 0 {
-		resolver := property.NewSourcesResolver(sources)
-		value, ok := resolver.Property("procyon.profiles.active")
-
-		if ok {
-			activeProfiles = strings.Split(strings.TrimSpace(value.(string)), ",")
+		resolver := property.NewSourcesResolver(c.unconditionalSources(defaultConfigs))
+		activeProfiles, err = property.GetOrDefault[[]string](resolver, "procyon.profiles.active", nil)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -54,7 +101,21 @@ func (c *configContextConfigurer) importConfig(environment runtime.Environment)
 			return err
 		}
 
-		err = c.loadActiveProfiles(environment, sources, activeProfiles)
+		err = c.importDotenv(environment, activeProfiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, defaultConfig := range defaultConfigs {
+		_, err = c.addIfActivated(environment, sources, defaultConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(activeProfiles) != 0 {
+		err = c.loadActiveProfiles(environment, locations, sources, activeProfiles)
 		if err != nil {
 			return err
 		}
@@ -64,17 +125,87 @@ func (c *configContextConfigurer) importConfig(environment runtime.Environment)
 	return nil
 }
 
-func (c *configContextConfigurer) loadActiveProfiles(environment runtime.Environment, sourceList *property.Sources, activeProfiles []string) error {
-	configs, err := c.importer.Import(context.Background(), "config", activeProfiles)
+// reimportConfig method imports the config files from the locations used by importConfig again for the current
+// profiles of the given environment, and replaces the config sources in the environment by the imported ones.
+// It returns the replaced sources and the imported sources. If the config files cannot be imported,
+// the environment is left as it is.
+func (c *configContextConfigurer) reimportConfig(environment runtime.Environment) ([]property.Source, []property.Source, error) {
+	c.mu.Lock()
+	locations := c.locations
+	previous := c.loaded
+	c.loaded = nil
+	c.mu.Unlock()
+
+	sources, err := c.activatedSources(environment, locations)
+	if err != nil {
+		c.mu.Lock()
+		c.loaded = previous
+		c.mu.Unlock()
+		return nil, nil, err
+	}
+
+	replaced := make([]property.Source, 0)
+	for _, cfg := range previous {
+		source := environment.PropertySources().Remove(cfg.PropertySource().Name())
+		if source != nil {
+			replaced = append(replaced, source)
+		}
+	}
+
+	c.mergeSources(environment, sources)
+	return replaced, sources.ToSlice(), nil
+}
+
+// activatedSources method imports the configs from the given locations and returns the sources of the ones
+// activated by the current profiles of the given environment.
+func (c *configContextConfigurer) activatedSources(environment runtime.Environment, locations *configLocations) (*property.Sources, error) {
+	if locations == nil {
+		return nil, errors.New("config is not imported")
+	}
+
+	defaultConfigs, err := c.importConfigs(locations, environment.DefaultProfiles())
+	if err != nil {
+		return nil, err
+	}
+
+	sources := property.NewSources()
+	for _, defaultConfig := range defaultConfigs {
+		_, err = c.addIfActivated(environment, sources, defaultConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	activeProfiles := environment.ActiveProfiles()
+	if len(activeProfiles) != 0 {
+		err = c.loadActiveProfiles(environment, locations, sources, activeProfiles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sources, nil
+}
+
+func (c *configContextConfigurer) loadActiveProfiles(environment runtime.Environment, locations *configLocations, sourceList *property.Sources, activeProfiles []string) error {
+	configs, err := c.importConfigs(locations, activeProfiles)
 	if err != nil {
 		return err
 	}
 
 	for _, cfg := range configs {
 		propertySource := cfg.PropertySource()
-		sourceList.AddFirst(propertySource)
 
-		err = c.activateIncludeProfiles(environment, sourceList, propertySource)
+		activated, err := c.addIfActivated(environment, sourceList, cfg)
+		if err != nil {
+			return err
+		}
+
+		if !activated {
+			continue
+		}
+
+		err = c.activateIncludeProfiles(environment, locations, sourceList, propertySource)
 		if err != nil {
 			return err
 		}
@@ -83,30 +214,204 @@ func (c *configContextConfigurer) loadActiveProfiles(environment runtime.Environ
 	return nil
 }
 
-func (c *configContextConfigurer) activateIncludeProfiles(environment runtime.Environment, sourceList *property.Sources, source property.Source) error {
-	value, ok := source.Property("procyon.profiles.include")
+func (c *configContextConfigurer) activateIncludeProfiles(environment runtime.Environment, locations *configLocations, sourceList *property.Sources, source property.Source) error {
+	sources := property.NewSources()
+	sources.AddLast(source)
 
-	if ok {
-		profiles := strings.Split(strings.TrimSpace(value.(string)), ",")
+	// the profiles given as a list are read from the flattened keys such as 'procyon.profiles.include.0'
+	profiles, err := property.GetOrDefault[[]string](property.NewSourcesResolver(sources), "procyon.profiles.include", nil)
+	if err != nil {
+		return err
+	}
 
+	if len(profiles) != 0 {
 		for _, profile := range profiles {
-			err := environment.AddActiveProfile(strings.TrimSpace(profile))
+			err = environment.AddActiveProfile(strings.TrimSpace(profile))
 			if err != nil {
 				return err
 			}
 		}
 
-		err := c.loadActiveProfiles(environment, sourceList, profiles)
+		err = c.loadActiveProfiles(environment, locations, sourceList, profiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configLocations method reads the locations and names of the config files from the environment, which contains
+// the arguments and the environment variables at this point. The 'procyon.config.location' property replaces
+// the default locations, and the locations in the 'procyon.config.additional-location' property are added after them,
+// so that they take precedence over the others.
+func (c *configContextConfigurer) configLocations(environment runtime.Environment) (*configLocations, error) {
+	resolver := environment.PropertyResolver()
+
+	names, err := property.GetOrDefault[[]string](resolver, config.NameProperty, []string{config.FileName})
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := property.GetOrDefault[[]string](resolver, config.LocationProperty, config.DefaultLocations)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalLocations, err := property.GetOrDefault[[]string](resolver, config.AdditionalLocationProperty, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &configLocations{
+		locations: append(slices.Clone(locations), additionalLocations...),
+		names:     names,
+	}, nil
+}
+
+// importConfigs method imports the configs from the given locations for the given profiles.
+// The configs of the later profiles and the later locations come later, so that they take precedence once added.
+func (c *configContextConfigurer) importConfigs(locations *configLocations, profiles []string) ([]*config.Config, error) {
+	configs := make([]*config.Config, 0)
+
+	for _, profile := range profiles {
+		for _, location := range locations.locations {
+			imported, err := c.importer.Import(context.Background(), location, locations.names, []string{profile})
+			if err != nil {
+				return nil, err
+			}
+
+			configs = append(configs, imported...)
+		}
+	}
+
+	return configs, nil
+}
+
+// unconditionalSources method returns the sources of the given configs which neither have any profile expression
+// nor are imported by such a config, so that the active profiles can be read from them before the profile expressions
+// are evaluated.
+func (c *configContextConfigurer) unconditionalSources(configs []*config.Config) *property.Sources {
+	sources := property.NewSources()
+
+	for _, cfg := range configs {
+		conditional := false
+
+		for current := cfg; current != nil && !conditional; current = current.Parent() {
+			conditional = c.hasProfileExpression(current.PropertySource())
+		}
+
+		if !conditional {
+			sources.AddFirst(cfg.PropertySource())
+		}
+	}
+
+	return sources
+}
+
+// hasProfileExpression method checks whether the given source has any profile expression.
+func (c *configContextConfigurer) hasProfileExpression(source property.Source) bool {
+	return source.ContainsProperty(config.ActivateOnProfileProperty) ||
+		source.ContainsProperty(config.ActivateOnProfileProperty+".0")
+}
+
+// addIfActivated method adds the source of the given config to the top of the source list if it is activated,
+// so that the later documents take precedence over the earlier ones. A source is activated if it has no profile
+// expression or any of its expressions in the 'procyon.config.activate.on-profile' property matches the active
+// profiles, or the default profiles if there is no active profile. An imported config is activated only if
+// the config importing it is activated.
+func (c *configContextConfigurer) addIfActivated(environment runtime.Environment, sourceList *property.Sources, cfg *config.Config) (bool, error) {
+	source := cfg.PropertySource()
+
+	if parent := cfg.Parent(); parent != nil {
+		parentSource, ok := sourceList.Find(parent.PropertySource().Name())
+		if !ok || parentSource != parent.PropertySource() {
+			return false, nil
+		}
+	}
+
+	if !c.hasProfileExpression(source) {
+		sourceList.AddFirst(source)
+		c.addLoadedConfig(cfg)
+		return true, nil
+	}
+
+	sources := property.NewSources()
+	sources.AddLast(source)
+
+	expressions, err := property.Get[[]string](property.NewSourcesResolver(sources), config.ActivateOnProfileProperty)
+	if err != nil {
+		return false, err
+	}
+
+	profiles, err := runtime.ParseProfiles(expressions...)
+	if err != nil {
+		return false, fmt.Errorf("%w in source '%s'", err, source.Name())
+	}
+
+	acceptedProfiles := environment.ActiveProfiles()
+	if len(acceptedProfiles) == 0 {
+		acceptedProfiles = environment.DefaultProfiles()
+	}
+
+	if !profiles.Matches(func(profile string) bool { return slices.Contains(acceptedProfiles, profile) }) {
+		return false, nil
+	}
+
+	sourceList.AddFirst(source)
+	c.addLoadedConfig(cfg)
+	return true, nil
+}
+
+// addLoadedConfig method records the given config whose source is added to the environment.
+func (c *configContextConfigurer) addLoadedConfig(cfg *config.Config) {
+	defer c.mu.Unlock()
+	c.mu.Lock()
+
+	c.loaded = append(c.loaded, cfg)
+}
+
+// loadedConfigs method returns the configs whose sources are added to the environment.
+func (c *configContextConfigurer) loadedConfigs() []*config.Config {
+	defer c.mu.Unlock()
+	c.mu.Lock()
+
+	return slices.Clone(c.loaded)
+}
+
+// importDotenv method adds the dotenv files in the current directory to the environment just after
+// the environment variables. If no profiles are given, the '.env' file is added, otherwise the profile-specific
+// files such as '.env.dev' are added, so that they take precedence over the '.env' file. The missing files are skipped.
+func (c *configContextConfigurer) importDotenv(environment runtime.Environment, profiles []string) error {
+	paths := []string{runtime.DotenvFileName}
+	if profiles != nil {
+		paths = paths[:0]
+
+		for _, profile := range profiles {
+			paths = append(paths, runtime.DotenvFileName+"."+strings.TrimSpace(profile))
+		}
+	}
+
+	for _, path := range paths {
+		source, err := runtime.LoadDotenvSource(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
 		if err != nil {
 			return err
 		}
+
+		environment.PropertySources().AddAfter(runtime.EnvironmentSourceName, source)
 	}
 
 	return nil
 }
 
+// mergeSources method adds the given config sources to the environment. They are added before the random source
+// if there is any, so that the random source keeps the lowest precedence.
 func (c *configContextConfigurer) mergeSources(environment runtime.Environment, sourceList *property.Sources) {
 	for _, source := range sourceList.ToSlice() {
-		environment.PropertySources().AddLast(source)
+		environment.PropertySources().AddBefore(property.RandomSourceName, source)
 	}
 }
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/config"
+	"codnect.io/procyon-core/runtime/property"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestConfigurer() *configContextConfigurer {
+	loaders := []property.SourceLoader{property.NewYamlSourceLoader()}
+	importer := config.NewImporter([]config.ResourceResolver{config.NewDefaultResourceResolver(loaders)}, []config.Loader{config.NewFileLoader()})
+	return newConfigContextConfigurer(loaders, importer)
+}
+
+func newTestEnvironment(t *testing.T, args ...string) *runtime.DefaultEnvironment {
+	arguments, err := runtime.ParseArguments(args)
+	assert.Nil(t, err)
+
+	environment := runtime.NewDefaultEnvironment()
+	environment.PropertySources().AddLast(runtime.NewArgumentsSource(arguments))
+	return environment
+}
+
+func writeConfigFile(t *testing.T, dir string, name string, content string) {
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+}
+
+func assertProperty(t *testing.T, environment runtime.Environment, name string, expected any) {
+	value, ok := environment.PropertyResolver().Property(name)
+	assert.True(t, ok, name)
+	assert.Equal(t, expected, value, name)
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldActivateDocumentsMatchingActiveProfiles(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", `procyon.name: base
+---
+procyon.config.activate.on-profile: dev | test
+procyon.name: dev
+---
+procyon.config.activate.on-profile: prod
+procyon.name: prod
+procyon.prod: true
+---
+procyon.config.activate.on-profile: default
+procyon.default: true
+`)
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir, "--procyon.profiles.active=dev")
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assert.Equal(t, []string{"dev"}, environment.ActiveProfiles())
+	assertProperty(t, environment, "procyon.name", "dev")
+	assert.False(t, environment.PropertyResolver().ContainsProperty("procyon.prod"))
+	assert.False(t, environment.PropertyResolver().ContainsProperty("procyon.default"))
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldActivateDocumentsMatchingDefaultProfilesIfNoProfileIsActive(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", `procyon.name: base
+---
+procyon.config.activate.on-profile: dev
+procyon.name: dev
+---
+procyon.config.activate.on-profile: default & !dev
+procyon.default: true
+`)
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir)
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assert.Empty(t, environment.ActiveProfiles())
+	assertProperty(t, environment, "procyon.name", "base")
+	assertProperty(t, environment, "procyon.default", true)
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldActivateImportedConfigOnlyIfItsParentIsActivated(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", `procyon.name: base
+---
+procyon.config.activate.on-profile: prod
+procyon.config.import: extra.yml
+`)
+	writeConfigFile(t, dir, "extra.yml", "procyon.extra: true\n")
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir, "--procyon.profiles.active=dev")
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assertProperty(t, environment, "procyon.name", "base")
+	assert.False(t, environment.PropertyResolver().ContainsProperty("procyon.extra"))
+
+	environment = newTestEnvironment(t, "--procyon.config.location="+dir, "--procyon.profiles.active=prod")
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assertProperty(t, environment, "procyon.extra", true)
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldGiveAdditionalLocationsPrecedenceOverLocations(t *testing.T) {
+	dir := t.TempDir()
+	additionalDir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", "procyon.name: location\nprocyon.location: true\n")
+	writeConfigFile(t, additionalDir, "procyon.yml", "procyon.name: additional-location\n")
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir, "--procyon.config.additional-location="+additionalDir)
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assertProperty(t, environment, "procyon.name", "additional-location")
+	assertProperty(t, environment, "procyon.location", true)
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldLoadConfigFilesByGivenNames(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", "procyon.default-name: true\n")
+	writeConfigFile(t, dir, "app.yml", "procyon.name: app\n")
+	writeConfigFile(t, dir, "other.yml", "procyon.name: other\n")
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir, "--procyon.config.name=app,other")
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assertProperty(t, environment, "procyon.name", "other")
+	assert.False(t, environment.PropertyResolver().ContainsProperty("procyon.default-name"))
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldSkipMissingOptionalLocations(t *testing.T) {
+	dir := t.TempDir()
+	missingDir := filepath.Join(dir, "missing")
+	writeConfigFile(t, dir, "procyon.yml", "procyon.name: location\n")
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir,
+		"--procyon.config.additional-location=optional:"+missingDir)
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assertProperty(t, environment, "procyon.name", "location")
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldReturnErrorIfLocationIsMissing(t *testing.T) {
+	missingDir := filepath.Join(t.TempDir(), "missing")
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+missingDir)
+	err := newTestConfigurer().importConfig(environment)
+
+	assert.ErrorIs(t, err, config.ErrLocationNotFound)
+}
+
+func TestConfigContextConfigurer_ImportConfigShouldActivateProfilesGivenAsLists(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "procyon.yml", "procyon.profiles.active: [dev]\n")
+	writeConfigFile(t, dir, "procyon-dev.yml", "procyon.profiles.include:\n  - local\n  - debug\n")
+	writeConfigFile(t, dir, "procyon-local.yml", "procyon.local: true\n")
+	writeConfigFile(t, dir, "procyon-debug.yml", "procyon.debug: true\n")
+
+	environment := newTestEnvironment(t, "--procyon.config.location="+dir)
+	assert.Nil(t, newTestConfigurer().importConfig(environment))
+
+	assert.Equal(t, []string{"dev", "local", "debug"}, environment.ActiveProfiles())
+	assertProperty(t, environment, "procyon.local", true)
+	assertProperty(t, environment, "procyon.debug", true)
+}
@@ -0,0 +1,483 @@
+package core
+
+import (
+	"cmp"
+	"codnect.io/procyon-core/component"
+	"codnect.io/procyon-core/component/container"
+	"codnect.io/procyon-core/component/filter"
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/conversion"
+	"codnect.io/procyon-core/runtime/event"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultContext struct is the default implementation of the runtime.Context interface.
+// It owns the container, the environment and the event multicaster of the application.
+type DefaultContext struct {
+	ctx         context.Context
+	container   container.Container
+	environment runtime.Environment
+	multicaster event.Multicaster
+	shutdown    *shutdownCoordinator
+
+	resolverNames   map[string]struct{}
+	configurerNames map[string]struct{}
+
+	running bool
+	closed  bool
+	mu      sync.RWMutex
+}
+
+// NewDefaultContext function creates a new DefaultContext with the given parent context.
+// The container, the environment, the event multicaster and the context itself are registered as singletons,
+// so that they can be injected into the components.
+func NewDefaultContext(parent context.Context) *DefaultContext {
+	if parent == nil {
+		panic("nil context")
+	}
+
+	ctx := &DefaultContext{
+		ctx:         parent,
+		container:   container.New(),
+		environment: runtime.NewDefaultEnvironment(),
+		multicaster: event.NewSimpleMulticaster(),
+		shutdown:    newShutdownCoordinator(),
+
+		resolverNames:   make(map[string]struct{}),
+		configurerNames: make(map[string]struct{}),
+	}
+
+	singletons := ctx.container.Singletons()
+	_ = singletons.Register("procyonContainer", ctx.container)
+	_ = singletons.Register("procyonEnvironment", ctx.environment)
+	_ = singletons.Register("procyonEventMulticaster", ctx.multicaster)
+	_ = singletons.Register("procyonContext", ctx)
+
+	return ctx
+}
+
+// Deadline method returns the time when work done on behalf of
+// this context should be canceled.
+func (c *DefaultContext) Deadline() (deadline time.Time, ok bool) {
+	return c.ctx.Deadline()
+}
+
+// Done method returns a channel that's closed when work done on behalf of
+// this context should be canceled.
+func (c *DefaultContext) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+// Err method returns a non-nil error value after Done is closed.
+func (c *DefaultContext) Err() error {
+	return c.ctx.Err()
+}
+
+// Value method returns the value associated with this context for key,
+// or nil if no value is associated with key.
+func (c *DefaultContext) Value(key any) any {
+	return c.ctx.Value(key)
+}
+
+// PublishEvent method publishes an event to the listeners synchronously.
+func (c *DefaultContext) PublishEvent(ctx context.Context, event event.ApplicationEvent) error {
+	return c.multicaster.MulticastEvent(ctx, event)
+}
+
+// PublishEventAsync method publishes an event to the listeners asynchronously.
+func (c *DefaultContext) PublishEventAsync(ctx context.Context, event event.ApplicationEvent) error {
+	return c.multicaster.MulticastEventAsync(ctx, event)
+}
+
+// Start method starts the application context.
+// It loads the registered components without conditions and runs the context configurers, so that the conditions
+// of the other components are evaluated against the properties in the config files. Then it loads the conditional
+// components, adds the object processors to the container, registers the event listeners,
+// instantiates the singletons, starts the runtime.Lifecycle components through the runtime.LifecycleProcessor
+// and finally publishes a runtime.StartupEvent. If the context cannot be started, the started components are stopped
+// and the container is closed, so that the objects created so far are destroyed.
+// Closing the container is terminal, a context which is stopped or cannot be started cannot be started again.
+func (c *DefaultContext) Start() error {
+	if c.IsRunning() {
+		return errors.New("context is already running")
+	}
+
+	if c.isClosed() {
+		return errors.New("context is closed")
+	}
+
+	err := c.refresh()
+	if err != nil {
+		return errors.Join(err, c.close())
+	}
+
+	err = c.startLifecycles()
+	if err != nil {
+		err = errors.Join(err, c.shutdown.shutdown(c.shutdownTimeout()))
+		return errors.Join(err, c.close())
+	}
+
+	c.mu.Lock()
+	c.running = true
+	c.mu.Unlock()
+
+	return c.PublishEvent(c, runtime.NewStartupEvent(c))
+}
+
+// Stop method stops the application context.
+// It publishes a runtime.ShutdownEvent, stops the started components in reverse start order
+// within the shutdown timeout configured by runtime.LifecycleProperties, and finally closes the container
+// to destroy the objects. The stopped context cannot be started again.
+func (c *DefaultContext) Stop() error {
+	if !c.IsRunning() {
+		return errors.New("context is not running")
+	}
+
+	err := c.PublishEvent(c, runtime.NewShutdownEvent(c))
+	err = errors.Join(err, c.shutdown.shutdown(c.shutdownTimeout()))
+	err = errors.Join(err, c.close())
+
+	defer c.mu.Unlock()
+	c.mu.Lock()
+
+	c.running = false
+	return err
+}
+
+// IsRunning method checks if the application context is running.
+func (c *DefaultContext) IsRunning() bool {
+	defer c.mu.Unlock()
+	c.mu.Lock()
+	return c.running
+}
+
+// AddEventListeners method adds event listeners to the application context.
+func (c *DefaultContext) AddEventListeners(listeners ...event.Listener) error {
+	for _, listener := range listeners {
+		err := c.multicaster.AddEventListener(listener)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Environment method returns the environment of the application context.
+func (c *DefaultContext) Environment() runtime.Environment {
+	return c.environment
+}
+
+// close method closes the container of the application context and marks the context as closed.
+func (c *DefaultContext) close() error {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+
+	return c.container.Close(context.WithoutCancel(c))
+}
+
+// isClosed method checks if the container of the application context is closed.
+func (c *DefaultContext) isClosed() bool {
+	defer c.mu.Unlock()
+	c.mu.Lock()
+	return c.closed
+}
+
+// Container method returns the container of the application context.
+func (c *DefaultContext) Container() container.Container {
+	return c.container
+}
+
+// refresh method prepares the container of the application context. The components without conditions are loaded
+// and the context configurers are run before the conditional components are loaded, so that the conditions such as
+// condition.OnProperty see the properties in the config files and the profile-specific config files.
+func (c *DefaultContext) refresh() error {
+	unconditional, conditional := partitionComponents(component.List())
+
+	err := c.loadComponents(unconditional)
+	if err != nil {
+		return err
+	}
+
+	err = c.configure()
+	if err != nil {
+		return err
+	}
+
+	err = c.loadComponents(conditional)
+	if err != nil {
+		return err
+	}
+
+	err = c.configure()
+	if err != nil {
+		return err
+	}
+
+	err = c.registerObjectProcessors()
+	if err != nil {
+		return err
+	}
+
+	err = c.registerEventListeners()
+	if err != nil {
+		return err
+	}
+
+	return c.instantiateSingletons()
+}
+
+// configure method registers the converters and the placeholder resolvers, and runs the context configurers
+// found in the container. The placeholder resolvers and the context configurers which are already registered
+// or run are skipped, so that the method can be called again after more components are loaded.
+func (c *DefaultContext) configure() error {
+	err := c.registerConverters()
+	if err != nil {
+		return err
+	}
+
+	err = c.registerPlaceholderResolvers()
+	if err != nil {
+		return err
+	}
+
+	return c.configureContext()
+}
+
+// loadComponents method loads the given components into the container.
+func (c *DefaultContext) loadComponents(components []*component.Component) error {
+	loader := component.NewLoader(c.container)
+	return loader.LoadComponents(c, components)
+}
+
+// partitionComponents function splits the given components into the ones without conditions and the conditional ones.
+func partitionComponents(components []*component.Component) ([]*component.Component, []*component.Component) {
+	unconditional := make([]*component.Component, 0)
+	conditional := make([]*component.Component, 0)
+
+	for _, item := range components {
+		if len(item.Conditions()) == 0 {
+			unconditional = append(unconditional, item)
+		} else {
+			conditional = append(conditional, item)
+		}
+	}
+
+	return unconditional, conditional
+}
+
+// registerConverters method registers the converters found in the container to the conversion service
+// of the environment, so that they are used while resolving and binding the properties.
+func (c *DefaultContext) registerConverters() error {
+	resolver, err := c.configurableResolver()
+	if err != nil {
+		return err
+	}
+
+	converters, err := listObjects[conversion.Converter](c, c.container)
+	if err != nil {
+		return err
+	}
+
+	service := resolver.ConversionService()
+	for _, converter := range converters {
+		service.RegisterConverter(converter)
+	}
+
+	return nil
+}
+
+// registerPlaceholderResolvers method adds the placeholder resolvers found in the container
+// to the property resolver of the environment.
+func (c *DefaultContext) registerPlaceholderResolvers() error {
+	resolver, err := c.configurableResolver()
+	if err != nil {
+		return err
+	}
+
+	resolvers, err := newObjects[property.PlaceholderResolver](c, c.container, c.resolverNames)
+	if err != nil {
+		return err
+	}
+
+	resolver.AddPlaceholderResolvers(resolvers...)
+	return nil
+}
+
+// configurableResolver method returns the property resolver of the environment as a property.ConfigurableResolver.
+// It returns an error if the property resolver cannot be configured.
+func (c *DefaultContext) configurableResolver() (property.ConfigurableResolver, error) {
+	resolver, ok := c.environment.PropertyResolver().(property.ConfigurableResolver)
+	if !ok {
+		return nil, fmt.Errorf("property resolver '%T' is not configurable", c.environment.PropertyResolver())
+	}
+
+	return resolver, nil
+}
+
+// registerObjectProcessors method adds the object processors found in the container to the container,
+// so that they are applied to the objects created afterwards.
+func (c *DefaultContext) registerObjectProcessors() error {
+	processors, err := listObjects[container.ObjectProcessor](c, c.container)
+	if err != nil {
+		return err
+	}
+
+	for _, processor := range processors {
+		err = c.container.AddObjectProcessor(processor)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configureContext method runs the context configurers found in the container which have not been run yet.
+func (c *DefaultContext) configureContext() error {
+	configurers, err := newObjects[runtime.ContextConfigurer](c, c.container, c.configurerNames)
+	if err != nil {
+		return err
+	}
+
+	for _, configurer := range configurers {
+		err = configurer.ConfigureContext(c)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerEventListeners method adds the event listeners found in the container to the multicaster.
+func (c *DefaultContext) registerEventListeners() error {
+	listeners, err := listObjects[event.Listener](c, c.container)
+	if err != nil {
+		return err
+	}
+
+	return c.AddEventListeners(listeners...)
+}
+
+// instantiateSingletons method creates the singleton objects which have not been created yet.
+func (c *DefaultContext) instantiateSingletons() error {
+	for _, definition := range sortDefinitions(c.container.Definitions().List()) {
+		if !definition.IsSingleton() {
+			continue
+		}
+
+		_, err := c.container.GetObject(c, filter.ByName(definition.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startLifecycles method starts the runtime.Lifecycle components through the runtime.LifecycleProcessor
+// in the container and registers the processor to stop them on shutdown. If there is no processor
+// in the container, a runtime.DefaultLifecycleProcessor is used.
+func (c *DefaultContext) startLifecycles() error {
+	var processor runtime.LifecycleProcessor
+
+	object, err := c.container.GetObject(c, filter.ByTypeOf[runtime.LifecycleProcessor]())
+	if err == nil {
+		processor = object.(runtime.LifecycleProcessor)
+	} else if errors.Is(err, container.ErrDefinitionNotFound) {
+		processor = runtime.NewDefaultLifecycleProcessor(c.container)
+	} else {
+		return err
+	}
+
+	c.shutdown.register(fmt.Sprintf("%T", processor), processor.OnStop)
+	return processor.OnStart(c)
+}
+
+// shutdownTimeout method returns the shutdown timeout configured by runtime.LifecycleProperties.
+func (c *DefaultContext) shutdownTimeout() time.Duration {
+	object, err := c.container.GetObject(c, filter.ByTypeOf[*runtime.LifecycleProperties]())
+	if err != nil {
+		return defaultShutdownTimeout
+	}
+
+	return object.(*runtime.LifecycleProperties).ShutdownTimeout
+}
+
+// listObjects function returns the objects of the given type in the container.
+// Unlike Container.ListObjects, it returns an error if any of the objects cannot be created.
+// The objects created from definitions are ordered by their priorities.
+func listObjects[T any](ctx context.Context, c container.Container) ([]T, error) {
+	return newObjects[T](ctx, c, make(map[string]struct{}))
+}
+
+// newObjects function returns the objects of the given type in the container whose names are not in the given
+// set of names, and adds their names to the set. Like listObjects, it returns an error if any of the objects
+// cannot be created, and the objects created from definitions are ordered by their priorities.
+func newObjects[T any](ctx context.Context, c container.Container, seen map[string]struct{}) ([]T, error) {
+	typ := reflect.TypeFor[T]()
+	objects := make([]T, 0)
+	names := make(map[string]struct{})
+
+	for _, definition := range sortDefinitions(c.Definitions().List(filter.ByType(typ))) {
+		names[definition.Name()] = struct{}{}
+		if _, exists := seen[definition.Name()]; exists {
+			continue
+		}
+
+		object, err := c.GetObject(ctx, filter.ByName(definition.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		seen[definition.Name()] = struct{}{}
+		objects = append(objects, object.(T))
+	}
+
+	singletonNames := c.Singletons().Names()
+	slices.Sort(singletonNames)
+
+	for _, name := range singletonNames {
+		if _, exists := names[name]; exists {
+			continue
+		}
+
+		if _, exists := seen[name]; exists {
+			continue
+		}
+
+		object, ok := c.Singletons().FindFirst(filter.ByName(name), filter.ByType(typ))
+		if ok {
+			seen[name] = struct{}{}
+			objects = append(objects, object.(T))
+		}
+	}
+
+	return objects, nil
+}
+
+// sortDefinitions function sorts the given definitions by their priorities and names.
+func sortDefinitions(definitions []*container.Definition) []*container.Definition {
+	slices.SortStableFunc(definitions, func(a, b *container.Definition) int {
+		if a.Priority() != b.Priority() {
+			return cmp.Compare(a.Priority(), b.Priority())
+		}
+
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return definitions
+}
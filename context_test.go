@@ -0,0 +1,183 @@
+package core
+
+import (
+	"codnect.io/procyon-core/component/container"
+	"codnect.io/procyon-core/component/filter"
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/event"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type anyRecorder struct {
+	records []string
+}
+
+func (r *anyRecorder) record(value string) {
+	r.records = append(r.records, value)
+}
+
+type anyProcessedObject struct {
+}
+
+type firstObjectProcessor struct {
+	recorder *anyRecorder
+}
+
+func (p *firstObjectProcessor) ProcessBeforeInit(ctx context.Context, object any) (any, error) {
+	if _, ok := object.(*anyProcessedObject); ok {
+		p.recorder.record("firstProcessor")
+	}
+
+	return object, nil
+}
+
+func (p *firstObjectProcessor) ProcessAfterInit(ctx context.Context, object any) (any, error) {
+	return object, nil
+}
+
+type secondObjectProcessor struct {
+	recorder *anyRecorder
+}
+
+func (p *secondObjectProcessor) ProcessBeforeInit(ctx context.Context, object any) (any, error) {
+	if _, ok := object.(*anyProcessedObject); ok {
+		p.recorder.record("secondProcessor")
+	}
+
+	return object, nil
+}
+
+func (p *secondObjectProcessor) ProcessAfterInit(ctx context.Context, object any) (any, error) {
+	return object, nil
+}
+
+type anyDestroyableObject struct {
+	recorder *anyRecorder
+}
+
+func (o *anyDestroyableObject) DoDestroy(ctx context.Context) error {
+	o.recorder.record("destroyed")
+	return nil
+}
+
+type anyMissingDependency struct {
+}
+
+type anyFailingObject struct {
+}
+
+func registerDefinition(t *testing.T, ctx *DefaultContext, constructorFunc container.ConstructorFunc, options ...container.DefinitionOption) {
+	definition, err := container.MakeDefinition(constructorFunc, options...)
+	assert.Nil(t, err)
+	assert.Nil(t, ctx.Container().Definitions().Register(definition))
+}
+
+func recordEvents(recorder *anyRecorder, name string) event.Listener {
+	return event.Listen(func(ctx context.Context, e event.ApplicationEvent) error {
+		switch e.(type) {
+		case runtime.StartupEvent:
+			recorder.record(name + ":startup")
+		case runtime.ShutdownEvent:
+			recorder.record(name + ":shutdown")
+		}
+
+		return nil
+	})
+}
+
+func TestDefaultContext_StartAndStopShouldPublishStartupAndShutdownEvents(t *testing.T) {
+	recorder := &anyRecorder{}
+	ctx := NewDefaultContext(context.Background())
+	assert.Nil(t, ctx.Container().Singletons().Register("anyListener", recordEvents(recorder, "anyListener")))
+
+	assert.Nil(t, ctx.Start())
+	assert.True(t, ctx.IsRunning())
+	assert.Equal(t, []string{"anyListener:startup"}, recorder.records)
+
+	assert.Nil(t, ctx.Stop())
+	assert.False(t, ctx.IsRunning())
+	assert.Equal(t, []string{"anyListener:startup", "anyListener:shutdown"}, recorder.records)
+}
+
+func TestDefaultContext_StartShouldReturnErrorIfContextIsAlreadyRunning(t *testing.T) {
+	ctx := NewDefaultContext(context.Background())
+
+	assert.Nil(t, ctx.Start())
+	assert.EqualError(t, ctx.Start(), "context is already running")
+	assert.Nil(t, ctx.Stop())
+}
+
+func TestDefaultContext_StopShouldReturnErrorIfContextIsNotRunning(t *testing.T) {
+	ctx := NewDefaultContext(context.Background())
+	assert.EqualError(t, ctx.Stop(), "context is not running")
+
+	assert.Nil(t, ctx.Start())
+	assert.Nil(t, ctx.Stop())
+	assert.EqualError(t, ctx.Stop(), "context is not running")
+}
+
+func TestDefaultContext_StartShouldApplyObjectProcessorsAndListenersInPriorityOrder(t *testing.T) {
+	recorder := &anyRecorder{}
+	ctx := NewDefaultContext(context.Background())
+
+	registerDefinition(t, ctx, func() *firstObjectProcessor {
+		return &firstObjectProcessor{recorder: recorder}
+	}, container.Named("aProcessor"), container.Prioritized(2))
+	registerDefinition(t, ctx, func() *secondObjectProcessor {
+		return &secondObjectProcessor{recorder: recorder}
+	}, container.Named("bProcessor"), container.Prioritized(1))
+	registerDefinition(t, ctx, func() event.Listener {
+		return recordEvents(recorder, "firstListener")
+	}, container.Named("aListener"), container.Prioritized(2))
+	registerDefinition(t, ctx, func() event.Listener {
+		return recordEvents(recorder, "secondListener")
+	}, container.Named("bListener"), container.Prioritized(1))
+	registerDefinition(t, ctx, func() *anyProcessedObject {
+		return &anyProcessedObject{}
+	}, container.Named("anyProcessedObject"))
+
+	assert.Nil(t, ctx.Start())
+	assert.Nil(t, ctx.Stop())
+
+	assert.Equal(t, []string{
+		"secondProcessor",
+		"firstProcessor",
+		"secondListener:startup",
+		"firstListener:startup",
+		"secondListener:shutdown",
+		"firstListener:shutdown",
+	}, recorder.records)
+}
+
+func TestDefaultContext_StartShouldCloseContainerIfRefreshFails(t *testing.T) {
+	recorder := &anyRecorder{}
+	ctx := NewDefaultContext(context.Background())
+
+	registerDefinition(t, ctx, func() *anyDestroyableObject {
+		return &anyDestroyableObject{recorder: recorder}
+	}, container.Named("aDestroyable"))
+	registerDefinition(t, ctx, func(dependency *anyMissingDependency) *anyFailingObject {
+		return &anyFailingObject{}
+	}, container.Named("bFailing"))
+
+	assert.NotNil(t, ctx.Start())
+	assert.False(t, ctx.IsRunning())
+	assert.Equal(t, []string{"destroyed"}, recorder.records)
+	assert.False(t, ctx.Container().Singletons().Contains("aDestroyable"))
+}
+
+func TestDefaultContext_StartShouldReturnErrorIfContextIsStopped(t *testing.T) {
+	ctx := NewDefaultContext(context.Background())
+
+	assert.Nil(t, ctx.Start())
+	assert.Nil(t, ctx.Stop())
+
+	assert.EqualError(t, ctx.Start(), "context is closed")
+	assert.False(t, ctx.IsRunning())
+
+	environment, err := ctx.Container().GetObject(ctx, filter.ByName("procyonEnvironment"))
+	assert.Nil(t, err)
+	assert.Equal(t, ctx.Environment(), environment)
+}
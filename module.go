@@ -15,6 +15,7 @@ type Module struct {
 func (m Module) InitModule() error {
 	// core
 	component.Register(newConfigContextConfigurer, component.WithName("procyonConfigContextConfigurer"))
+	component.Register(newConfigReloader, component.WithName("procyonConfigReloader"))
 	// runtime/event
 	component.Register(event.NewSimpleMulticaster, component.WithName("procyonEventMulticaster"),
 		component.WithCondition(condition.OnMissingType[event.Multicaster]()),
@@ -22,11 +23,25 @@ func (m Module) InitModule() error {
 	// runtime/config
 	component.Register(config.NewDefaultResourceResolver, component.WithName("procyonDefaultConfigResourceResolver"))
 	component.Register(config.NewFileLoader, component.WithName("procyonConfigFileLoader"))
+	component.Register(config.NewConfigTreeResourceResolver, component.WithName("procyonConfigTreeResourceResolver"))
+	component.Register(config.NewConfigTreeLoader, component.WithName("procyonConfigTreeLoader"))
 	component.Register(config.NewImporter, component.WithName("procyonConfigImporter"))
+	component.Register(config.NewReloadProperties, component.WithSingletonScope())
 	// runtime/property
 	component.Register(property.NewYamlSourceLoader, component.WithName("procyonYamlPropertySourceLoader"))
+	component.Register(property.NewPropertiesSourceLoader, component.WithName("procyonPropertiesPropertySourceLoader"))
+	component.Register(property.NewJsonSourceLoader, component.WithName("procyonJsonPropertySourceLoader"))
+	component.Register(property.NewEnvPlaceholderResolver, component.WithName("procyonEnvPlaceholderResolver"))
+	component.Register(newFilePlaceholderResolver, component.WithName("procyonFilePlaceholderResolver"))
+	component.Register(property.NewBase64PlaceholderResolver, component.WithName("procyonBase64PlaceholderResolver"))
+	component.Register(newRandomPlaceholderResolver, component.WithName("procyonRandomPlaceholderResolver"))
 	// runtime
 	component.Register(runtime.NewServerProperties, component.WithPrototypeScope())
 	component.Register(runtime.NewLifecycleProperties, component.WithSingletonScope())
+	component.Register(runtime.NewPropertiesProcessor, component.WithName("procyonPropertiesProcessor"))
+	component.Register(runtime.NewPropertiesRebinder, component.WithName("procyonPropertiesRebinder"))
+	component.Register(runtime.NewDefaultLifecycleProcessor, component.WithName("procyonLifecycleProcessor"),
+		component.WithCondition(condition.OnMissingType[runtime.LifecycleProcessor]()),
+	)
 	return nil
 }
@@ -3,6 +3,13 @@ package module
 import (
 	"fmt"
 	"reflect"
+	"sync"
+)
+
+// initializedModules is a map that stores the types of the initialized modules.
+var (
+	initializedModules   = make(map[reflect.Type]struct{})
+	muInitializedModules = sync.Mutex{}
 )
 
 // Module is an interface that represents a module.
@@ -14,8 +21,16 @@ type Module interface {
 }
 
 // Use creates a new instance of the module and initializes it.
+// A module is initialized only once, subsequent calls for the same module have no effect.
 func Use[M Module]() {
+	defer muInitializedModules.Unlock()
+	muInitializedModules.Lock()
+
 	moduleType := reflect.TypeFor[M]()
+	if _, initialized := initializedModules[moduleType]; initialized {
+		return
+	}
+
 	if moduleType.Kind() == reflect.Struct {
 		moduleValue := reflect.New(moduleType)
 
@@ -24,5 +39,7 @@ func Use[M Module]() {
 		if err != nil {
 			panic(fmt.Errorf("failed to initialize the module '%s': %e", moduleType.Name(), err))
 		}
+
+		initializedModules[moduleType] = struct{}{}
 	}
 }
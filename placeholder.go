@@ -0,0 +1,26 @@
+package core
+
+import (
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/property"
+)
+
+// newFilePlaceholderResolver function creates a new file placeholder resolver which caches the file contents,
+// so that the files are read only once.
+func newFilePlaceholderResolver() property.PlaceholderResolver {
+	return property.NewCachingPlaceholderResolver(property.NewFilePlaceholderResolver())
+}
+
+// newRandomPlaceholderResolver function creates a new random placeholder resolver. The values are generated by
+// the random source of the given environment, or by a new random source if there is none. The random values are
+// not cached, so that each placeholder such as '${random.uuid}' is resolved to a new value.
+func newRandomPlaceholderResolver(environment runtime.Environment) property.PlaceholderResolver {
+	source, ok := environment.PropertySources().Find(property.RandomSourceName)
+	randomSource, isRandomSource := source.(*property.RandomSource)
+
+	if !ok || !isRandomSource {
+		randomSource = property.NewRandomSource()
+	}
+
+	return property.NewRandomPlaceholderResolver(randomSource)
+}
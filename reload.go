@@ -0,0 +1,305 @@
+package core
+
+import (
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/config"
+	"codnect.io/procyon-core/runtime/event"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// configFileState struct holds the state of a config file when it was last checked.
+type configFileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// configReloader struct polls the config files loaded by the configContextConfigurer once the context starts,
+// if reloading is enabled by config.ReloadProperties. When a file changes, the sources loaded from it are rebuilt
+// and replaced in place in the environment, and a runtime.EnvironmentChangedEvent listing the changed properties
+// is published. The polling stops when the context shuts down. The embedded files and the config trees are not
+// polled, since the former never change and the latter reread their files by themselves.
+type configReloader struct {
+	configurer  *configContextConfigurer
+	environment runtime.Environment
+	multicaster event.Multicaster
+	properties  *config.ReloadProperties
+
+	files map[string]configFileState
+	stop  chan struct{}
+	done  chan struct{}
+	mu    sync.Mutex
+}
+
+func newConfigReloader(configurer *configContextConfigurer, environment runtime.Environment, multicaster event.Multicaster,
+	properties *config.ReloadProperties) *configReloader {
+	return &configReloader{
+		configurer:  configurer,
+		environment: environment,
+		multicaster: multicaster,
+		properties:  properties,
+		files:       make(map[string]configFileState),
+	}
+}
+
+// OnEvent method starts polling on a runtime.StartupEvent and stops polling on a runtime.ShutdownEvent.
+func (r *configReloader) OnEvent(ctx context.Context, e event.ApplicationEvent) error {
+	switch e.(type) {
+	case runtime.StartupEvent:
+		r.start(ctx)
+	case runtime.ShutdownEvent:
+		r.shutdown()
+	}
+
+	return nil
+}
+
+// SupportsEvent method checks if the event is a runtime.StartupEvent or a runtime.ShutdownEvent.
+func (r *configReloader) SupportsEvent(e event.ApplicationEvent) bool {
+	switch e.(type) {
+	case runtime.StartupEvent, runtime.ShutdownEvent:
+		return true
+	}
+
+	return false
+}
+
+// start method starts polling the config files if reloading is enabled and it is not started yet.
+func (r *configReloader) start(ctx context.Context) {
+	defer r.mu.Unlock()
+	r.mu.Lock()
+
+	if !r.properties.Enabled || r.stop != nil {
+		return
+	}
+
+	for _, location := range r.locations() {
+		if state, ok := r.stat(location); ok {
+			r.files[location] = state
+		}
+	}
+
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+
+	go r.poll(context.WithoutCancel(ctx), r.stop, r.done)
+}
+
+// shutdown method stops polling and waits for the ongoing check to finish.
+func (r *configReloader) shutdown() {
+	r.mu.Lock()
+	stop, done := r.stop, r.done
+	r.stop, r.done = nil, nil
+	r.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	<-done
+}
+
+// poll method checks the config files by the configured interval until it is stopped.
+func (r *configReloader) poll(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(r.properties.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			r.check(ctx)
+		}
+	}
+}
+
+// check method reloads the config files which have changed since they were last checked.
+func (r *configReloader) check(ctx context.Context) {
+	for location, previous := range r.files {
+		current, ok := r.stat(location)
+		if !ok || current == previous {
+			continue
+		}
+
+		r.files[location] = current
+
+		err := r.reload(ctx, location)
+		if err != nil {
+			log.E(ctx, "Config file '{}' could not be reloaded: {}", location, err.Error())
+		}
+	}
+}
+
+// reload method rebuilds the sources loaded from the config file at the given location and replaces
+// the sources in the environment by them. If any property has changed, it publishes a runtime.EnvironmentChangedEvent.
+// The sources are matched by their names, which depend on the number of the documents in the file. Therefore, if
+// the documents rebuilt from the file do not match the loaded ones, such as when a document is added to or removed
+// from the file, or when the file has documents which are not activated, all config files are imported again.
+func (r *configReloader) reload(ctx context.Context, location string) error {
+	configs, err := r.configurer.importer.Import(ctx, location, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	rebuilt := make(map[string]property.Source)
+	for _, cfg := range configs {
+		if cfg.Resource() != nil && cfg.Resource().Location() == location {
+			rebuilt[cfg.PropertySource().Name()] = cfg.PropertySource()
+		}
+	}
+
+	loaded := make([]string, 0)
+	for _, cfg := range r.configurer.loadedConfigs() {
+		if cfg.Resource() != nil && cfg.Resource().Location() == location {
+			loaded = append(loaded, cfg.PropertySource().Name())
+		}
+	}
+
+	var changedKeys []string
+	if len(loaded) == len(rebuilt) && !slices.ContainsFunc(loaded, func(name string) bool { return rebuilt[name] == nil }) {
+		changedKeys = r.replaceSources(loaded, rebuilt)
+	} else {
+		changedKeys, err = r.reimport()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(changedKeys) == 0 {
+		return nil
+	}
+
+	slices.Sort(changedKeys)
+	return r.multicaster.MulticastEvent(ctx, runtime.NewEnvironmentChangedEvent(r.environment, slices.Compact(changedKeys)))
+}
+
+// replaceSources method replaces the sources with the given names in the environment by the rebuilt ones
+// and returns the names of the changed properties.
+func (r *configReloader) replaceSources(names []string, rebuilt map[string]property.Source) []string {
+	sources := r.environment.PropertySources()
+	changedKeys := make([]string, 0)
+
+	for _, name := range names {
+		current, ok := sources.Find(name)
+		if !ok {
+			continue
+		}
+
+		keys := changedPropertyNames(current, rebuilt[name])
+		if len(keys) == 0 {
+			continue
+		}
+
+		sources.Replace(name, rebuilt[name])
+		changedKeys = append(changedKeys, keys...)
+	}
+
+	return changedKeys
+}
+
+// reimport method imports all config files again, replaces the config sources in the environment
+// and returns the names of the changed properties. The locations of the config files are polled
+// from then on, since the imported files may have changed as well.
+func (r *configReloader) reimport() ([]string, error) {
+	replaced, imported, err := r.configurer.reimportConfig(r.environment)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, location := range r.locations() {
+		if _, ok := r.files[location]; ok {
+			continue
+		}
+
+		if state, ok := r.stat(location); ok {
+			r.files[location] = state
+		}
+	}
+
+	// the names of the sources may change along with the documents, so the effective values are compared
+	previous := property.NewMapSource("previous", effectiveProperties(replaced))
+	current := property.NewMapSource("current", effectiveProperties(imported))
+	return changedPropertyNames(previous, current), nil
+}
+
+// effectiveProperties function returns the properties of the given sources, which are ordered by their precedence.
+// The value of a property is read from the first source which contains it.
+func effectiveProperties(sources []property.Source) map[string]any {
+	properties := make(map[string]any)
+
+	for _, source := range sources {
+		for _, name := range source.PropertyNames() {
+			if _, exists := properties[name]; exists {
+				continue
+			}
+
+			properties[name], _ = source.Property(name)
+		}
+	}
+
+	return properties
+}
+
+// locations method returns the locations of the loaded config files. The locations which are not
+// in the file system of the operating system, such as the embedded ones, are skipped once they cannot be stated.
+func (r *configReloader) locations() []string {
+	locations := make([]string, 0)
+
+	for _, cfg := range r.configurer.loadedConfigs() {
+		resource, ok := cfg.Resource().(*config.FileResource)
+		if !ok {
+			continue
+		}
+
+		if !slices.Contains(locations, resource.Location()) {
+			locations = append(locations, resource.Location())
+		}
+	}
+
+	return locations
+}
+
+// stat method returns the state of the config file at the given location.
+func (r *configReloader) stat(location string) (configFileState, bool) {
+	info, err := os.Stat(location)
+	if err != nil {
+		return configFileState{}, false
+	}
+
+	return configFileState{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}, true
+}
+
+// changedPropertyNames function returns the names of the properties which are added, removed or changed
+// in the given new source compared to the given old source.
+func changedPropertyNames(old property.Source, new property.Source) []string {
+	changed := make([]string, 0)
+
+	for _, name := range old.PropertyNames() {
+		oldValue, _ := old.Property(name)
+		newValue, ok := new.Property(name)
+
+		if !ok || !reflect.DeepEqual(oldValue, newValue) {
+			changed = append(changed, name)
+		}
+	}
+
+	for _, name := range new.PropertyNames() {
+		if !old.ContainsProperty(name) {
+			changed = append(changed, name)
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"codnect.io/procyon-core/runtime"
+	"codnect.io/procyon-core/runtime/config"
+	"codnect.io/procyon-core/runtime/event"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func startTestConfigReloader(t *testing.T, dir string) (runtime.Environment, chan runtime.EnvironmentChangedEvent) {
+	t.Setenv("PROCYON_CONFIG_LOCATION", dir)
+
+	loaders := []property.SourceLoader{property.NewYamlSourceLoader()}
+	importer := config.NewImporter([]config.ResourceResolver{config.NewDefaultResourceResolver(loaders)}, []config.Loader{config.NewFileLoader()})
+	configurer := newConfigContextConfigurer(loaders, importer)
+
+	environment := runtime.NewDefaultEnvironment()
+	environment.PropertySources().AddLast(runtime.NewEnvironmentSource())
+	assert.Nil(t, configurer.importConfig(environment))
+
+	events := make(chan runtime.EnvironmentChangedEvent, 1)
+	multicaster := event.NewSimpleMulticaster()
+	assert.Nil(t, multicaster.AddEventListener(event.Listen(func(ctx context.Context, e runtime.EnvironmentChangedEvent) error {
+		events <- e
+		return nil
+	})))
+
+	properties := &config.ReloadProperties{Enabled: true, Interval: 10 * time.Millisecond}
+	reloader := newConfigReloader(configurer, environment, multicaster, properties)
+	assert.Nil(t, reloader.OnEvent(context.Background(), runtime.NewStartupEvent(nil)))
+	t.Cleanup(func() {
+		reloader.OnEvent(context.Background(), runtime.NewShutdownEvent(nil))
+	})
+
+	return environment, events
+}
+
+func writeChangedConfigFile(t *testing.T, path string, content string) {
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+	assert.Nil(t, os.Chtimes(path, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+}
+
+func TestConfigReloader_ShouldReplaceSourcesAndPublishEventWhenConfigFileChanges(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "procyon.yml")
+	assert.Nil(t, os.WriteFile(path, []byte("procyon.server.port: 8080\nprocyon.name: anyName\n"), 0600))
+
+	environment, events := startTestConfigReloader(t, dir)
+	writeChangedConfigFile(t, path, "procyon.server.port: 9090\nprocyon.name: anyName\nprocyon.added: true\n")
+
+	select {
+	case changed := <-events:
+		assert.Equal(t, []string{"procyon.added", "procyon.server.port"}, changed.Keys())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no environment changed event is published")
+	}
+
+	value, ok := environment.PropertyResolver().Property("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, 9090, value)
+}
+
+func TestConfigReloader_ShouldImportConfigAgainWhenDocumentsOfConfigFileChange(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "procyon.yml")
+	assert.Nil(t, os.WriteFile(path, []byte("procyon.server.port: 8080\nprocyon.name: anyName\n"), 0600))
+
+	environment, events := startTestConfigReloader(t, dir)
+	writeChangedConfigFile(t, path, "procyon.server.port: 8080\nprocyon.name: anyName\n---\nprocyon.added: true\n")
+
+	select {
+	case changed := <-events:
+		assert.Equal(t, []string{"procyon.added"}, changed.Keys())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no environment changed event is published")
+	}
+
+	assertProperty(t, environment, "procyon.server.port", 8080)
+	assertProperty(t, environment, "procyon.name", "anyName")
+	assertProperty(t, environment, "procyon.added", true)
+}
+
+func TestConfigReloader_ShouldNotPollIfReloadingIsDisabled(t *testing.T) {
+	reloader := newConfigReloader(nil, nil, nil, &config.ReloadProperties{})
+	assert.Nil(t, reloader.OnEvent(context.Background(), runtime.NewStartupEvent(nil)))
+	assert.Nil(t, reloader.stop)
+}
@@ -2,7 +2,6 @@ package runtime
 
 import (
 	"errors"
-	"flag"
 	"os"
 	"strings"
 )
@@ -10,6 +9,7 @@ import (
 // Arguments struct represents the command line arguments passed to the application.
 type Arguments struct {
 	optArgs     map[string][]string
+	optIndexes  map[string]int
 	nonOptsArgs []string
 }
 
@@ -17,6 +17,7 @@ type Arguments struct {
 func newArguments() *Arguments {
 	return &Arguments{
 		optArgs:     make(map[string][]string),
+		optIndexes:  make(map[string]int),
 		nonOptsArgs: make([]string, 0),
 	}
 }
@@ -56,32 +57,48 @@ func (a *Arguments) addOptionArgs(name string, value string) {
 	a.optArgs[name] = append(a.optArgs[name], value)
 }
 
+// optionIndex method returns the index of the first occurrence of the option argument with the given name.
+// It returns false if the index of the option argument is not recorded.
+func (a *Arguments) optionIndex(name string) (int, bool) {
+	index, ok := a.optIndexes[name]
+	return index, ok
+}
+
+// addOptionIndex method records the index of the option argument with the given name
+// unless the index of a previous occurrence is recorded.
+func (a *Arguments) addOptionIndex(name string, index int) {
+	if _, ok := a.optIndexes[name]; !ok {
+		a.optIndexes[name] = index
+	}
+}
+
 // addNonOptionArgs method adds a non-option argument to the arguments.
 func (a *Arguments) addNonOptionArgs(value string) {
 	a.nonOptsArgs = append(a.nonOptsArgs, value)
 }
 
-// mergeArguments function merges the command line arguments with the given arguments.
+// mergeArguments function returns the given arguments, or the command line arguments if no argument is given.
+// The program name is not a part of the command line arguments.
 func mergeArguments(args ...string) []string {
-	mergedArgs := make([]string, 0)
-	copy(mergedArgs, os.Args)
-	mergedArgs = append(mergedArgs, args...)
-	return mergedArgs
+	if len(args) != 0 {
+		return args
+	}
+
+	if len(os.Args) > 1 {
+		return os.Args[1:]
+	}
+
+	return make([]string, 0)
 }
 
-// ParseArguments function parses the given and the command line arguments and returns an Arguments.
+// ParseArguments function parses the given arguments and returns an Arguments. If no argument is given,
+// the command line arguments are parsed instead. The indexes of the option arguments are their positions
+// in the parsed arguments.
 func ParseArguments(args []string) (*Arguments, error) {
 	mergedArgs := mergeArguments(args...)
 	cmdLineArgs := newArguments()
-	appArgumentFlagSet := flag.NewFlagSet("ApplicationArguments", flag.ContinueOnError)
-
-	err := appArgumentFlagSet.Parse(mergedArgs)
-
-	if err != nil {
-		return cmdLineArgs, err
-	}
 
-	for _, arg := range appArgumentFlagSet.Args() {
+	for index, arg := range mergedArgs {
 
 		if strings.HasPrefix(arg, "--") {
 			optionText := arg[2:]
@@ -104,6 +121,7 @@ func ParseArguments(args []string) (*Arguments, error) {
 			}
 
 			cmdLineArgs.addOptionArgs(optionName, optionValue)
+			cmdLineArgs.addOptionIndex(optionName, index)
 		} else {
 			cmdLineArgs.addNonOptionArgs(arg)
 		}
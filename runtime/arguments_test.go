@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestParseArguments_ShouldParseOnlyGivenArgumentsIfAnyArgumentIsGiven(t *testing.T) {
+	args, err := ParseArguments([]string{"--procyon.server.port=8080", "anyArg"})
+	assert.Nil(t, err)
+
+	assert.ElementsMatch(t, []string{"procyon.server.port"}, args.OptionNames())
+	assert.Equal(t, []string{"anyArg"}, args.NonOptionArgs())
+}
+
+func TestParseArguments_ShouldParseCommandLineArgumentsIfNoArgumentIsGiven(t *testing.T) {
+	commandLineArgs := os.Args
+	defer func() {
+		os.Args = commandLineArgs
+	}()
+
+	os.Args = []string{"anyProgram", "anyArg", "--procyon.server.port=8080"}
+
+	args, err := ParseArguments(nil)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"8080"}, args.OptionValues("procyon.server.port"))
+	assert.Equal(t, []string{"anyArg"}, args.NonOptionArgs())
+
+	index, ok := args.optionIndex("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, 1, index)
+}
@@ -4,8 +4,19 @@ import (
 	"codnect.io/procyon-core/runtime/property"
 )
 
+const (
+	// ActivateOnProfileProperty is the name of the property which holds the profile expressions
+	// of a configuration, such as 'prod & !eu'. The configuration is applied only if any of them matches.
+	ActivateOnProfileProperty = "procyon.config.activate.on-profile"
+	// ImportProperty is the name of the property which holds the locations of the config files
+	// imported by a configuration, such as 'optional:./shared.yml, file:/etc/app/secrets.yml'.
+	ImportProperty = "procyon.config.import"
+)
+
 type Config struct {
-	source property.Source
+	source   property.Source
+	parent   *Config
+	resource Resource
 }
 
 // New function creates a new Config.
@@ -15,10 +26,22 @@ func New(source property.Source) *Config {
 	}
 
 	return &Config{
-		source,
+		source: source,
 	}
 }
 
 func (d *Config) PropertySource() property.Source {
 	return d.source
 }
+
+// Parent method returns the configuration which imports the configuration.
+// It returns nil if the configuration is not imported by another one.
+func (d *Config) Parent() *Config {
+	return d.parent
+}
+
+// Resource method returns the resource from which the configuration is loaded.
+// It returns nil if the configuration is not loaded by an Importer.
+func (d *Config) Resource() Resource {
+	return d.resource
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+const (
+	// ConfigTreePrefix is the prefix of the config tree locations, such as 'configtree:/etc/config/'.
+	ConfigTreePrefix = "configtree:"
+)
+
+// ConfigTreeResource is a struct that represents a directory tree in which each file is a property.
+type ConfigTreeResource struct {
+	dir string
+}
+
+// newConfigTreeResource function creates a new ConfigTreeResource with the provided directory.
+func newConfigTreeResource(dir string) *ConfigTreeResource {
+	if strings.TrimSpace(dir) == "" {
+		panic("cannot create config tree resource with empty or blank directory")
+	}
+
+	return &ConfigTreeResource{
+		dir: dir,
+	}
+}
+
+// Directory method returns the directory of the config tree.
+func (r *ConfigTreeResource) Directory() string {
+	return r.dir
+}
+
+// Location method returns the location of the config tree, such as 'configtree:/etc/config'.
+func (r *ConfigTreeResource) Location() string {
+	return ConfigTreePrefix + r.dir
+}
+
+// Name method returns the name of the directory.
+func (r *ConfigTreeResource) Name() string {
+	return filepath.Base(r.dir)
+}
+
+// Profile method returns an empty profile since a config tree is not specific to a profile.
+func (r *ConfigTreeResource) Profile() string {
+	return ""
+}
+
+// Loader method returns nil since a config tree is not loaded by a property.SourceLoader.
+func (r *ConfigTreeResource) Loader() property.SourceLoader {
+	return nil
+}
+
+// ConfigTreeResourceResolver struct resolves the config tree locations, such as 'configtree:/etc/config/'.
+type ConfigTreeResourceResolver struct {
+}
+
+// NewConfigTreeResourceResolver function creates a new ConfigTreeResourceResolver.
+func NewConfigTreeResourceResolver() *ConfigTreeResourceResolver {
+	return &ConfigTreeResourceResolver{}
+}
+
+// IsResolvable method checks if the location starts with the 'configtree:' prefix.
+func (r *ConfigTreeResourceResolver) IsResolvable(location string) bool {
+	return strings.HasPrefix(location, ConfigTreePrefix)
+}
+
+// ResolveResources method resolves the directory of the config tree location. Since a config tree is not
+// specific to a profile, it is resolved only for the default profile, and the given names are ignored.
+// It returns an error wrapping ErrLocationNotFound if the directory does not exist.
+func (r *ConfigTreeResourceResolver) ResolveResources(ctx context.Context, location string, names []string, profiles []string) ([]Resource, error) {
+	for _, profile := range profiles {
+		if profile != "" && profile != "default" {
+			return []Resource{}, nil
+		}
+	}
+
+	dir := filepath.Clean(strings.TrimPrefix(location, ConfigTreePrefix))
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: '%s'", ErrLocationNotFound, location)
+		}
+
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("config tree location '%s' is not a directory", location)
+	}
+
+	return []Resource{newConfigTreeResource(dir)}, nil
+}
+
+// ConfigTreeLoader is a struct that represents a config tree loader.
+type ConfigTreeLoader struct {
+}
+
+// NewConfigTreeLoader function creates a new ConfigTreeLoader.
+func NewConfigTreeLoader() *ConfigTreeLoader {
+	return &ConfigTreeLoader{}
+}
+
+// IsLoadable method checks if a resource is a config tree resource.
+func (l *ConfigTreeLoader) IsLoadable(resource Resource) bool {
+	_, canConvert := resource.(*ConfigTreeResource)
+	return canConvert
+}
+
+// LoadConfigs method loads a configuration from a config tree resource. The property source of
+// the configuration rereads the files when they change.
+func (l *ConfigTreeLoader) LoadConfigs(ctx context.Context, resource Resource) ([]*Config, error) {
+	if ctx == nil {
+		return nil, errors.New("nil context")
+	}
+
+	if resource == nil {
+		return nil, errors.New("nil resource")
+	}
+
+	if treeResource, ok := resource.(*ConfigTreeResource); ok {
+		source, err := property.NewConfigTreeSource(treeResource.Location(), treeResource.Directory())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*Config{New(source)}, nil
+	}
+
+	return nil, fmt.Errorf("resource '%s' is not supported", reflect.TypeOf(resource).Name())
+}
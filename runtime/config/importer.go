@@ -1,11 +1,43 @@
 package config
 
 import (
+	"codnect.io/procyon-core/runtime/property"
 	"context"
+	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
 	"reflect"
+	"slices"
+	"strings"
 )
 
+const (
+	// NameProperty is the name of the property which holds the names of the config files.
+	NameProperty = "procyon.config.name"
+	// LocationProperty is the name of the property which holds the locations of the config files.
+	// It replaces the default locations.
+	LocationProperty = "procyon.config.location"
+	// AdditionalLocationProperty is the name of the property which holds the locations of the config files
+	// in addition to the default locations.
+	AdditionalLocationProperty = "procyon.config.additional-location"
+	// OptionalPrefix is the prefix of the config locations which are skipped if they do not exist.
+	OptionalPrefix = "optional:"
+	// FilePrefix is the prefix of the config locations in the file system, which may be omitted.
+	FilePrefix = "file:"
+	// EmbedPrefix is the prefix of the config locations in the embedded file system.
+	EmbedPrefix = "embed:"
+)
+
+// DefaultLocations are the locations in which the config files are looked up by default.
+// The embedded locations come first, so that the external config files take precedence over the embedded ones.
+var DefaultLocations = []string{
+	OptionalPrefix + EmbedPrefix + "resources",
+	OptionalPrefix + EmbedPrefix + "config",
+	OptionalPrefix + "resources",
+	OptionalPrefix + "config",
+}
+
 // Importer struct is responsible for importing configurations.
 // It uses ResourceResolvers to resolve resources and Loaders to load configurations from these resources.
 type Importer struct {
@@ -23,52 +55,216 @@ func NewImporter(resolvers []ResourceResolver, loaders []Loader) *Importer {
 
 // Import method imports configurations from a location for specific profiles.
 // It first resolves resources from the location and then loads configurations from these resources.
-func (i *Importer) Import(ctx context.Context, location string, profiles []string) ([]*Config, error) {
-	resources, err := i.resolve(ctx, location, profiles)
+// The location is either a directory in which the config files with the given names are looked up,
+// or an explicit config file. If the location starts with the 'optional:' prefix, it is skipped
+// when it does not exist, otherwise an error wrapping ErrLocationNotFound is returned.
+//
+// The locations in the 'procyon.config.import' property of a loaded configuration are imported recursively.
+// The relative locations are resolved against the directory of the importing resource, and the imported
+// configurations come just after the importing one, so that they take precedence over it once added.
+// A resource imported more than once is loaded only once, and the circular imports result in an error.
+func (i *Importer) Import(ctx context.Context, location string, names []string, profiles []string) ([]*Config, error) {
+	state := &importState{
+		loaded: make(map[string]struct{}),
+		chain:  make([]string, 0),
+	}
+
+	return i.importLocation(ctx, location, names, profiles, nil, state)
+}
+
+// importState struct keeps track of the resources loaded by a single import.
+type importState struct {
+	loaded map[string]struct{} // The locations of the loaded resources.
+	chain  []string            // The locations of the resources being imported.
+}
+
+// importLocation method imports configurations from a location, which may start with the 'optional:' prefix.
+// The given parent is the configuration importing the location, if there is any.
+func (i *Importer) importLocation(ctx context.Context, location string, names []string, profiles []string, parent *Config, state *importState) ([]*Config, error) {
+	location = strings.TrimSpace(location)
+	path, optional := strings.CutPrefix(location, OptionalPrefix)
+
+	resources, err := i.resolve(ctx, strings.TrimSpace(path), names, profiles)
 	if err != nil {
+		if optional && errors.Is(err, ErrLocationNotFound) {
+			return []*Config{}, nil
+		}
+
 		return nil, err
 	}
 
-	return i.load(ctx, resources)
+	return i.load(ctx, resources, names, parent, state)
 }
 
-// resolve method resolves resources from a location for specific profiles using the resolvers
-func (i *Importer) resolve(ctx context.Context, location string, profiles []string) ([]Resource, error) {
+// resolve method resolves resources from a location for specific profiles using the resolvers supporting the location.
+// It returns an error wrapping ErrLocationNotFound if no resolver supports the location.
+func (i *Importer) resolve(ctx context.Context, location string, names []string, profiles []string) ([]Resource, error) {
 	resources := make([]Resource, 0)
+	resolvable := false
 
 	for _, resolver := range i.resolvers {
-		resolved, err := resolver.ResolveResources(ctx, location, profiles)
+		if !resolver.IsResolvable(location) {
+			continue
+		}
+
+		resolvable = true
+		resolved, err := resolver.ResolveResources(ctx, location, names, profiles)
 
 		if err != nil {
+			closeResources(resources)
 			return nil, err
 		}
 
 		resources = append(resources, resolved...)
 	}
 
+	if !resolvable {
+		return nil, fmt.Errorf("%w: no resolver found for '%s'", ErrLocationNotFound, location)
+	}
+
 	return resources, nil
 }
 
-// load method loads configurations from the resolved resources using the loaders.
-func (i *Importer) load(ctx context.Context, resources []Resource) ([]*Config, error) {
+// load method loads configurations from the resolved resources using the loaders,
+// and imports the locations declared by the loaded configurations.
+// The resources are closed after they are loaded, or when the loading fails.
+func (i *Importer) load(ctx context.Context, resources []Resource, names []string, parent *Config, state *importState) ([]*Config, error) {
+	defer closeResources(resources)
+
 	loaded := make([]*Config, 0)
 
 	for _, resource := range resources {
+		key := resourceKey(resource)
+
+		if slices.Contains(state.chain, key) {
+			return nil, fmt.Errorf("circular config import: %s", strings.Join(append(state.chain, key), " -> "))
+		}
+
+		if _, ok := state.loaded[key]; ok {
+			continue
+		}
+
+		state.loaded[key] = struct{}{}
+
 		loader, err := i.findLoader(resource)
 
 		if err != nil {
 			return nil, err
 		}
 
-		var data *Config
-		data, err = loader.LoadConfig(ctx, resource)
+		var configs []*Config
+		configs, err = loader.LoadConfigs(ctx, resource)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config from '%s': %w", resource.Location(), err)
+		}
+
+		state.chain = append(state.chain, key)
+
+		for _, cfg := range configs {
+			cfg.parent = parent
+			cfg.resource = resource
+			loaded = append(loaded, cfg)
+
+			var imported []*Config
+			imported, err = i.importConfigs(ctx, resource, cfg, names, state)
+
+			if err != nil {
+				return nil, err
+			}
 
-		loaded = append(loaded, data)
+			loaded = append(loaded, imported...)
+		}
+
+		state.chain = state.chain[:len(state.chain)-1]
 	}
 
 	return loaded, nil
 }
 
+// importConfigs method imports the locations in the 'procyon.config.import' property of the given configuration.
+func (i *Importer) importConfigs(ctx context.Context, resource Resource, cfg *Config, names []string, state *importState) ([]*Config, error) {
+	sources := property.NewSources()
+	sources.AddLast(cfg.PropertySource())
+
+	locations, err := property.GetOrDefault[[]string](property.NewSourcesResolver(sources), ImportProperty, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make([]*Config, 0)
+
+	for _, location := range locations {
+		if strings.TrimSpace(location) == "" {
+			continue
+		}
+
+		configs, importErr := i.importLocation(ctx, relativeLocation(resource, location), names, nil, cfg, state)
+		if importErr != nil {
+			return nil, fmt.Errorf("failed to import '%s' from '%s': %w", location, resource.Location(), importErr)
+		}
+
+		imported = append(imported, configs...)
+	}
+
+	return imported, nil
+}
+
+// relativeLocation function resolves the given location against the directory of the given resource
+// if the location is relative. The location without a scheme inherits the scheme of the resource,
+// such as 'embed:', and the 'optional:' and 'file:' prefixes of the location are kept.
+func relativeLocation(resource Resource, location string) string {
+	location = strings.TrimSpace(location)
+
+	prefix := ""
+	if trimmed, ok := strings.CutPrefix(location, OptionalPrefix); ok {
+		prefix = OptionalPrefix
+		location = strings.TrimSpace(trimmed)
+	}
+
+	resourceScheme := schemePattern.FindString(resource.Location())
+
+	if resourceScheme != "" && resourceScheme != FilePrefix {
+		if schemePattern.MatchString(location) {
+			return prefix + location
+		}
+
+		if !strings.HasPrefix(location, "/") {
+			location = path.Join(path.Dir(strings.TrimPrefix(resource.Location(), resourceScheme)), location)
+		}
+
+		return prefix + resourceScheme + location
+	}
+
+	if trimmed, ok := strings.CutPrefix(location, FilePrefix); ok {
+		prefix += FilePrefix
+		location = trimmed
+	} else if schemePattern.MatchString(location) {
+		return prefix + location
+	}
+
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(filepath.Dir(resource.Location()), location)
+	}
+
+	return prefix + location
+}
+
+// resourceKey function returns the location of the given resource, which identifies the resource.
+// The locations in the file system of the operating system are made absolute.
+func resourceKey(resource Resource) string {
+	if schemePattern.MatchString(resource.Location()) {
+		return resource.Location()
+	}
+
+	key, err := filepath.Abs(resource.Location())
+	if err != nil {
+		return resource.Location()
+	}
+
+	return key
+}
+
 // findLoader method finds a suitable loader for a resource.
 // It returns an error if no loader or multiple loaders are found for the resource.
 func (i *Importer) findLoader(resource Resource) (Loader, error) {
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func newTestImporter() *Importer {
+	loaders := []property.SourceLoader{property.NewYamlSourceLoader(), property.NewPropertiesSourceLoader()}
+	return NewImporter([]ResourceResolver{NewDefaultResourceResolver(loaders)}, []Loader{NewFileLoader()})
+}
+
+func writeTestFile(t *testing.T, path string, content string) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0700))
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestImporter_ImportShouldLoadConfigFilesWithGivenNamesFromDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "app.yml"), "procyon.server.port: 8080")
+	writeTestFile(t, filepath.Join(dir, "app-dev.properties"), "procyon.server.port=9090")
+	writeTestFile(t, filepath.Join(dir, "procyon.yml"), "procyon.server.port: 7070")
+
+	importer := newTestImporter()
+
+	configs, err := importer.Import(context.Background(), dir, []string{"app"}, []string{"default"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+	assert.Equal(t, filepath.Join(dir, "app.yml"), configs[0].PropertySource().Name())
+
+	configs, err = importer.Import(context.Background(), dir, []string{"app"}, []string{"dev"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+	assert.Equal(t, filepath.Join(dir, "app-dev.properties"), configs[0].PropertySource().Name())
+}
+
+func TestImporter_ImportShouldLoadExplicitConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "app.yml"), "procyon.server.port: 8080")
+	writeTestFile(t, filepath.Join(dir, "app-prod.yml"), "procyon.server.port: 9090")
+
+	importer := newTestImporter()
+
+	configs, err := importer.Import(context.Background(), filepath.Join(dir, "app.yml"), nil, []string{"default"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+
+	value, _ := configs[0].PropertySource().Property("procyon.server.port")
+	assert.Equal(t, 8080, value)
+
+	configs, err = importer.Import(context.Background(), filepath.Join(dir, "app.yml"), nil, []string{"prod"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+	assert.Equal(t, filepath.Join(dir, "app-prod.yml"), configs[0].PropertySource().Name())
+
+	configs, err = importer.Import(context.Background(), filepath.Join(dir, "app.yml"), nil, []string{"dev"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 0)
+}
+
+func TestImporter_ImportShouldReturnErrorIfLocationDoesNotExist(t *testing.T) {
+	dir := t.TempDir()
+	importer := newTestImporter()
+
+	_, err := importer.Import(context.Background(), filepath.Join(dir, "missing"), nil, nil)
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+
+	_, err = importer.Import(context.Background(), filepath.Join(dir, "missing.yml"), nil, nil)
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+}
+
+func TestImporter_ImportShouldSkipOptionalLocationIfItDoesNotExist(t *testing.T) {
+	dir := t.TempDir()
+	importer := newTestImporter()
+
+	configs, err := importer.Import(context.Background(), "optional:"+filepath.Join(dir, "missing"), nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, configs, 0)
+
+	configs, err = importer.Import(context.Background(), "optional:"+filepath.Join(dir, "missing.yml"), nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, configs, 0)
+}
+
+func TestImporter_ImportShouldReturnErrorIfConfigCannotBeLoaded(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "procyon.properties"), "procyon.greeting=\\u00")
+
+	importer := newTestImporter()
+
+	_, err := importer.Import(context.Background(), dir, nil, nil)
+	assert.ErrorContains(t, err, "failed to load config from '"+filepath.Join(dir, "procyon.properties")+"'")
+}
+
+func TestImporter_ImportShouldImportConfigFilesRecursively(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "procyon.yml"), "procyon.config.import: optional:./missing.yml, shared/shared.yml, file:"+filepath.Join(dir, "secrets.properties"))
+	writeTestFile(t, filepath.Join(dir, "shared", "shared.yml"), "procyon.config.import: ../common.yml")
+	writeTestFile(t, filepath.Join(dir, "common.yml"), "procyon.server.port: 8080")
+	writeTestFile(t, filepath.Join(dir, "secrets.properties"), "procyon.secret=anySecret")
+
+	importer := newTestImporter()
+
+	configs, err := importer.Import(context.Background(), dir, nil, nil)
+	assert.Nil(t, err)
+
+	names := make([]string, 0)
+	for _, cfg := range configs {
+		names = append(names, cfg.PropertySource().Name())
+	}
+
+	assert.Equal(t, []string{
+		filepath.Join(dir, "procyon.yml"),
+		filepath.Join(dir, "shared", "shared.yml"),
+		filepath.Join(dir, "common.yml"),
+		filepath.Join(dir, "secrets.properties"),
+	}, names)
+	assert.Nil(t, configs[0].Parent())
+	assert.Equal(t, configs[0], configs[1].Parent())
+	assert.Equal(t, configs[1], configs[2].Parent())
+	assert.Equal(t, configs[0], configs[3].Parent())
+}
+
+func TestImporter_ImportShouldReturnErrorIfImportIsCircular(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "procyon.yml"), "procyon.config.import: first.yml")
+	writeTestFile(t, filepath.Join(dir, "first.yml"), "procyon.config.import: procyon.yml")
+
+	importer := newTestImporter()
+
+	_, err := importer.Import(context.Background(), dir, nil, nil)
+	assert.ErrorContains(t, err, "circular config import")
+}
+
+func TestImporter_ImportShouldReturnErrorIfImportedFileDoesNotExist(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "procyon.yml"), "procyon.config.import: missing.yml")
+
+	importer := newTestImporter()
+
+	_, err := importer.Import(context.Background(), dir, nil, nil)
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+}
+
+func TestImporter_ImportShouldLoadConfigFilesFromEmbeddedFileSystem(t *testing.T) {
+	fsys := fstest.MapFS{
+		"resources/procyon.yml":              {Data: []byte("procyon.config.import: shared/shared.properties")},
+		"resources/procyon-dev.yml":          {Data: []byte("procyon.server.port: 9090")},
+		"resources/shared/shared.properties": {Data: []byte("procyon.server.port=8080")},
+	}
+
+	loaders := []property.SourceLoader{property.NewYamlSourceLoader(), property.NewPropertiesSourceLoader()}
+	importer := NewImporter([]ResourceResolver{
+		NewDefaultResourceResolver(loaders),
+		NewEmbedResourceResolver(fsys, loaders),
+	}, []Loader{NewFileLoader()})
+
+	configs, err := importer.Import(context.Background(), "embed:/resources/", nil, []string{"default", "dev"})
+	assert.Nil(t, err)
+
+	names := make([]string, 0)
+	for _, cfg := range configs {
+		names = append(names, cfg.PropertySource().Name())
+	}
+
+	assert.Equal(t, []string{
+		"embed:resources/procyon.yml",
+		"embed:resources/shared/shared.properties",
+		"embed:resources/procyon-dev.yml",
+	}, names)
+
+	_, err = importer.Import(context.Background(), "embed:config", nil, nil)
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+
+	configs, err = importer.Import(context.Background(), "optional:embed:config/procyon.yml", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, configs, 0)
+}
+
+func TestImporter_ImportShouldReturnErrorIfNoResolverSupportsLocation(t *testing.T) {
+	importer := newTestImporter()
+
+	_, err := importer.Import(context.Background(), "embed:resources", nil, nil)
+	assert.EqualError(t, err, "config location not found: no resolver found for 'embed:resources'")
+
+	configs, err := importer.Import(context.Background(), "optional:embed:resources", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, configs, 0)
+}
+
+func TestImporter_ImportShouldLoadConfigTree(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "procyon", "datasource", "password"), "secret\n")
+
+	importer := NewImporter([]ResourceResolver{NewConfigTreeResourceResolver()}, []Loader{NewConfigTreeLoader()})
+
+	configs, err := importer.Import(context.Background(), "configtree:"+dir, nil, []string{"default"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+	assert.Equal(t, "configtree:"+dir, configs[0].PropertySource().Name())
+
+	value, ok := configs[0].PropertySource().Property("procyon.datasource.password")
+	assert.True(t, ok)
+	assert.Equal(t, "secret", value)
+
+	configs, err = importer.Import(context.Background(), "configtree:"+dir, nil, []string{"dev"})
+	assert.Nil(t, err)
+	assert.Len(t, configs, 0)
+
+	_, err = importer.Import(context.Background(), "configtree:"+filepath.Join(dir, "missing"), nil, nil)
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"codnect.io/procyon-core/runtime/property"
 	"context"
 	"errors"
 	"fmt"
@@ -9,9 +10,10 @@ import (
 
 // Loader is an interface that represents a configuration loader.
 // It provides methods to check if a resource is loadable and to load configurations from a resource.
+// A resource may contain more than one configuration, such as a YAML file having multiple documents.
 type Loader interface {
 	IsLoadable(resource Resource) bool
-	LoadConfig(ctx context.Context, resource Resource) (*Config, error)
+	LoadConfigs(ctx context.Context, resource Resource) ([]*Config, error)
 }
 
 // FileLoader is a struct that represents a file loader.
@@ -30,10 +32,12 @@ func (l *FileLoader) IsLoadable(resource Resource) bool {
 	return canConvert
 }
 
-// LoadConfig method loads configurations from a file resource.
-// It returns a configuration and an error if the loading fails.
-func (l *FileLoader) LoadConfig(ctx context.Context, resource Resource) (*Config, error) {
-	if resource == nil {
+// LoadConfigs method loads configurations from a file resource. If the loader of the resource supports
+// multiple documents, a configuration is returned for each document in the order of the documents.
+// The sources are named after the location of the resource, so that the files having the same name
+// in different locations are distinguished. It returns an error if the loading fails.
+func (l *FileLoader) LoadConfigs(ctx context.Context, resource Resource) ([]*Config, error) {
+	if ctx == nil {
 		return nil, errors.New("nil context")
 	}
 
@@ -43,13 +47,28 @@ func (l *FileLoader) LoadConfig(ctx context.Context, resource Resource) (*Config
 
 	if fileResource, ok := resource.(*FileResource); ok {
 		loader := fileResource.Loader()
-		source, err := loader.Load(fileResource.Name(), fileResource.File())
+
+		if documentLoader, supportsDocuments := loader.(property.MultiDocumentSourceLoader); supportsDocuments {
+			sources, err := documentLoader.LoadDocuments(fileResource.Location(), fileResource.File())
+			if err != nil {
+				return nil, err
+			}
+
+			configs := make([]*Config, 0, len(sources))
+			for _, source := range sources {
+				configs = append(configs, New(source))
+			}
+
+			return configs, nil
+		}
+
+		source, err := loader.Load(fileResource.Location(), fileResource.File())
 
 		if err != nil {
 			return nil, err
 		}
 
-		return New(source), err
+		return []*Config{New(source)}, nil
 	}
 
 	return nil, fmt.Errorf("resource '%s' is not supported", reflect.TypeOf(resource).Name())
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"codnect.io/procyon-core/runtime/property"
+	"time"
+)
+
+// ReloadProperties struct represents the properties of reloading the config files when they change.
+// Reloading is disabled by default, and the config files are polled by the given interval once it is enabled.
+type ReloadProperties struct {
+	property.Properties `prefix:"procyon.config.reload"`
+
+	Enabled  bool          `prop:"enabled"`
+	Interval time.Duration `prop:"interval" default:"5000" validate:"min=10ms"`
+}
+
+// NewReloadProperties function creates a new ReloadProperties.
+func NewReloadProperties() *ReloadProperties {
+	return &ReloadProperties{}
+}
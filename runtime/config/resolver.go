@@ -3,61 +3,178 @@ package config
 import (
 	"codnect.io/procyon-core/runtime/property"
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 )
 
 const (
 	FileName = "procyon"
 )
 
+// ErrLocationNotFound is returned when a config location does not exist.
+var ErrLocationNotFound = errors.New("config location not found")
+
+// schemePattern matches the locations starting with a scheme such as 'file:' or 'embed:'.
+// The single letter schemes are not matched, so that the Windows paths such as 'C:\config' are not mistaken.
+var schemePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]+:`)
+
 // ResourceResolver is an interface that represents a resource resolver.
+// The location is either a directory, in which the config files with the given names are looked up,
+// or an explicit config file.
 type ResourceResolver interface {
-	ResolveResources(ctx context.Context, location string, profiles []string) ([]Resource, error)
+	IsResolvable(location string) bool
+	ResolveResources(ctx context.Context, location string, names []string, profiles []string) ([]Resource, error)
 }
 
+// DefaultResourceResolver struct resolves the config files in the file system of the operating system,
+// or in the given fs.FS such as an embed.FS.
 type DefaultResourceResolver struct {
-	loaders    []property.SourceLoader
-	configName string
+	loaders []property.SourceLoader
+	fsys    fs.FS
+	scheme  string
 }
 
 // NewDefaultResourceResolver function creates a new DefaultResourceResolver with the provided loaders.
+// It resolves the locations without a scheme or with the 'file:' scheme in the file system of the operating system.
 func NewDefaultResourceResolver(loaders []property.SourceLoader) *DefaultResourceResolver {
 	return &DefaultResourceResolver{
-		loaders:    loaders,
-		configName: FileName,
+		loaders: loaders,
+		scheme:  FilePrefix,
+	}
+}
+
+// NewEmbedResourceResolver function creates a new DefaultResourceResolver with the provided loaders,
+// which resolves the locations with the 'embed:' scheme, such as 'embed:resources', in the given file system.
+// It is registered as a component wrapping an embed.FS, so that the default config files can ship inside the binary.
+func NewEmbedResourceResolver(fsys fs.FS, loaders []property.SourceLoader) *DefaultResourceResolver {
+	return NewFSResourceResolver(EmbedPrefix, fsys, loaders)
+}
+
+// NewFSResourceResolver function creates a new DefaultResourceResolver with the provided loaders,
+// which resolves the locations with the given scheme, such as 'embed:', in the given file system.
+func NewFSResourceResolver(scheme string, fsys fs.FS, loaders []property.SourceLoader) *DefaultResourceResolver {
+	if !schemePattern.MatchString(scheme) || !strings.HasSuffix(scheme, ":") {
+		panic(fmt.Sprintf("invalid scheme '%s'", scheme))
+	}
+
+	if fsys == nil {
+		panic("nil file system")
+	}
+
+	return &DefaultResourceResolver{
+		loaders: loaders,
+		fsys:    fsys,
+		scheme:  scheme,
 	}
 }
 
+// IsResolvable method checks if the location has the scheme of the resolver.
+// The locations without a scheme are resolvable if the resolver uses the file system of the operating system.
+func (r *DefaultResourceResolver) IsResolvable(location string) bool {
+	if strings.HasPrefix(location, r.scheme) {
+		return true
+	}
+
+	return r.fsys == nil && !schemePattern.MatchString(location)
+}
+
 // ResolveResources method resolves resources from a location for specific profiles.
-// It returns a list of resources and an error if the resolution fails.
-func (r *DefaultResourceResolver) ResolveResources(ctx context.Context, location string, profiles []string) ([]Resource, error) {
+// If the location is a directory, the files such as 'procyon.yml' and 'procyon-dev.yml' are resolved for
+// each of the given names, otherwise the location is resolved as a file, and its profile-specific variants
+// such as 'app-dev.yml' for 'app.yml' are resolved if they exist. If no names are given, the default name is used.
+// It returns an error wrapping ErrLocationNotFound if the location does not exist.
+func (r *DefaultResourceResolver) ResolveResources(ctx context.Context, location string, names []string, profiles []string) ([]Resource, error) {
+	location = r.cleanPath(strings.TrimPrefix(location, r.scheme))
+
+	if len(names) == 0 {
+		names = []string{FileName}
+	}
+
+	if len(profiles) == 0 {
+		profiles = []string{""}
+	}
+
+	info, err := r.stat(location)
+	isDirectory := err == nil && info.IsDir()
+
+	if !isDirectory && r.extension(location) != "" && r.findLoader(location) != nil {
+		return r.resolveFile(location, profiles)
+	}
+
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: '%s'", ErrLocationNotFound, r.location(location))
+		}
+
+		return nil, err
+	}
+
+	if !isDirectory {
+		return nil, fmt.Errorf("config location '%s' is neither a directory nor a supported config file", r.location(location))
+	}
+
 	resources := make([]Resource, 0)
-	if profiles == nil {
-		resources = append(resources, r.getResources("", location)...)
-		return resources, nil
+
+	for _, profile := range profiles {
+		for _, name := range names {
+			resolved, resolveErr := r.getResources(location, strings.TrimSpace(name), profile)
+			if resolveErr != nil {
+				closeResources(resources)
+				return nil, resolveErr
+			}
+
+			resources = append(resources, resolved...)
+		}
 	}
 
+	return resources, nil
+}
+
+// resolveFile method resolves the given config file and its profile-specific variants.
+// The file itself is resolved for the default profile, and it must exist.
+func (r *DefaultResourceResolver) resolveFile(filePath string, profiles []string) ([]Resource, error) {
+	resources := make([]Resource, 0)
+	extension := r.extension(filePath)
+	loader := r.findLoader(filePath)
+
 	for _, profile := range profiles {
-		if profile == "default" {
-			resources = append(resources, r.getResources("", location)...)
-		} else {
-			resources = append(resources, r.getResources(profile, location)...)
+		profilePath := filePath
+		if profile != "" && profile != "default" {
+			profilePath = fmt.Sprintf("%s-%s%s", strings.TrimSuffix(filePath, extension), profile, extension)
+		}
+
+		resource, err := r.openResource(profilePath, loader)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && profilePath != filePath {
+				continue
+			}
+
+			closeResources(resources)
+
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("%w: '%s'", ErrLocationNotFound, r.location(filePath))
+			}
+
+			return nil, err
 		}
+
+		resources = append(resources, resource)
 	}
 
 	return resources, nil
 }
 
-// getResources method gets resources from a location for a specific profile.
-// It returns a list of resources.
-func (r *DefaultResourceResolver) getResources(profile string, location string) []Resource {
-	var (
-		configFile fs.File
-		resources  = make([]Resource, 0)
-	)
+// getResources method gets resources from a location for a specific name and profile.
+// It returns a list of resources and an error if an existing file cannot be opened.
+func (r *DefaultResourceResolver) getResources(location string, name string, profile string) ([]Resource, error) {
+	resources := make([]Resource, 0)
 
 	for _, loader := range r.loaders {
 		extensions := loader.FileExtensions()
@@ -65,23 +182,110 @@ func (r *DefaultResourceResolver) getResources(profile string, location string)
 		for _, extension := range extensions {
 			filePath := ""
 
-			if profile == "" {
-				filePath = filepath.Join(location, fmt.Sprintf("%s.%s", r.configName, extension))
+			if profile == "" || profile == "default" {
+				filePath = r.join(location, fmt.Sprintf("%s.%s", name, extension))
 			} else {
-				filePath = filepath.Join(location, fmt.Sprintf("%s-%s.%s", r.configName, profile, extension))
+				filePath = r.join(location, fmt.Sprintf("%s-%s.%s", name, profile, extension))
 			}
 
-			if _, err := os.Stat(filePath); err == nil {
-				configFile, err = os.Open(filePath)
-
-				if err != nil {
-					continue
-				}
+			resource, err := r.openResource(filePath, loader)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
 
-				resources = append(resources, newFileResource(filePath, configFile, loader))
+			if err != nil {
+				closeResources(resources)
+				return nil, err
 			}
+
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources, nil
+}
+
+// openResource method opens the file at the given path as a resource.
+func (r *DefaultResourceResolver) openResource(filePath string, loader property.SourceLoader) (Resource, error) {
+	var (
+		configFile fs.File
+		err        error
+	)
+
+	if r.fsys == nil {
+		configFile, err = os.Open(filePath)
+	} else {
+		configFile, err = r.fsys.Open(filePath)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newFileResource(r.location(filePath), configFile, loader), nil
+}
+
+// stat method returns the file info of the given path.
+func (r *DefaultResourceResolver) stat(filePath string) (fs.FileInfo, error) {
+	if r.fsys == nil {
+		return os.Stat(filePath)
+	}
+
+	return fs.Stat(r.fsys, filePath)
+}
+
+// join method joins the given path elements by the separator of the file system.
+func (r *DefaultResourceResolver) join(elements ...string) string {
+	if r.fsys == nil {
+		return filepath.Join(elements...)
+	}
+
+	return path.Join(elements...)
+}
+
+// cleanPath method converts the given path to a valid path of the file system. The paths in a fs.FS
+// are unrooted and slash-separated, so that the leading slashes and dots are removed.
+func (r *DefaultResourceResolver) cleanPath(filePath string) string {
+	if r.fsys == nil {
+		return filePath
+	}
+
+	cleaned := path.Clean("/" + filepath.ToSlash(filePath))[1:]
+	if cleaned == "" {
+		return "."
+	}
+
+	return cleaned
+}
+
+// location method returns the location of the given path, which has the scheme of the resolver
+// unless the resolver uses the file system of the operating system.
+func (r *DefaultResourceResolver) location(filePath string) string {
+	if r.fsys == nil {
+		return filePath
+	}
+
+	return r.scheme + filePath
+}
+
+// extension method returns the extension of the given path, including the leading dot.
+func (r *DefaultResourceResolver) extension(filePath string) string {
+	if r.fsys == nil {
+		return filepath.Ext(filePath)
+	}
+
+	return path.Ext(filePath)
+}
+
+// findLoader method finds the loader supporting the extension of the given file path.
+func (r *DefaultResourceResolver) findLoader(filePath string) property.SourceLoader {
+	extension := strings.TrimPrefix(r.extension(filePath), ".")
+
+	for _, loader := range r.loaders {
+		if slices.Contains(loader.FileExtensions(), extension) {
+			return loader
 		}
 	}
 
-	return resources
+	return nil
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"codnect.io/procyon-core/runtime/property"
+	"io"
 	"io/fs"
 	"path/filepath"
 	"strings"
@@ -75,3 +76,17 @@ func (r *FileResource) Profile() string {
 func (r *FileResource) Loader() property.SourceLoader {
 	return r.loader
 }
+
+// Close method closes the file.
+func (r *FileResource) Close() error {
+	return r.file.Close()
+}
+
+// closeResources function closes the given resources which can be closed.
+func closeResources(resources []Resource) {
+	for _, resource := range resources {
+		if closer, ok := resource.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package conversion
+
+import (
+	"reflect"
+)
+
+// Converter interface represents a converter which converts values to a specific target type.
+// The converters are registered to a Service by their target types.
+type Converter interface {
+	// TargetType returns the type which the converter converts values to.
+	TargetType() reflect.Type
+	// Convert converts the given value to the target type.
+	Convert(value any) (any, error)
+}
+
+// funcConverter struct is a Converter implementation backed by a function.
+type funcConverter[T any] struct {
+	fn func(value any) (T, error)
+}
+
+// NewConverter function creates a new Converter which converts values to the type T by the given function.
+func NewConverter[T any](fn func(value any) (T, error)) Converter {
+	if fn == nil {
+		panic("nil function")
+	}
+
+	return &funcConverter[T]{
+		fn: fn,
+	}
+}
+
+// TargetType method returns the type T.
+func (c *funcConverter[T]) TargetType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// Convert method converts the given value to the type T.
+func (c *funcConverter[T]) Convert(value any) (any, error) {
+	return c.fn(value)
+}
@@ -0,0 +1,239 @@
+package conversion
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts holds the layouts which are used for parsing times, in order.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.DateTime,
+	time.DateOnly,
+	time.TimeOnly,
+}
+
+// defaultConverters function returns the converters registered to a DefaultService by default.
+func defaultConverters() []Converter {
+	return []Converter{
+		NewConverter(toString),
+		NewConverter(toBool),
+		newIntConverter[int](),
+		newIntConverter[int8](),
+		newIntConverter[int16](),
+		newIntConverter[int32](),
+		newIntConverter[int64](),
+		newUintConverter[uint](),
+		newUintConverter[uint8](),
+		newUintConverter[uint16](),
+		newUintConverter[uint32](),
+		newUintConverter[uint64](),
+		newFloatConverter[float32](),
+		newFloatConverter[float64](),
+		NewConverter(toDuration),
+		NewConverter(toDataSize),
+		NewConverter(toTime),
+		NewConverter(toURL),
+		NewConverter(toURLPointer),
+	}
+}
+
+// newIntConverter function creates a new Converter for the given signed integer type.
+func newIntConverter[T int | int8 | int16 | int32 | int64]() Converter {
+	return NewConverter(func(value any) (T, error) {
+		parsed, err := toInt(value)
+		if err != nil {
+			return 0, err
+		}
+
+		result := T(parsed)
+		if int64(result) != parsed {
+			return 0, fmt.Errorf("value %d overflows %T", parsed, result)
+		}
+
+		return result, nil
+	})
+}
+
+// newUintConverter function creates a new Converter for the given unsigned integer type.
+func newUintConverter[T uint | uint8 | uint16 | uint32 | uint64]() Converter {
+	return NewConverter(func(value any) (T, error) {
+		var result T
+
+		if typed, ok := value.(uint64); ok {
+			result = T(typed)
+			if uint64(result) != typed {
+				return 0, fmt.Errorf("value %d overflows %T", typed, result)
+			}
+
+			return result, nil
+		}
+
+		parsed, err := toInt(value)
+		if err != nil {
+			return 0, err
+		}
+
+		result = T(parsed)
+		if parsed < 0 || uint64(result) != uint64(parsed) {
+			return 0, fmt.Errorf("value %d overflows %T", parsed, result)
+		}
+
+		return result, nil
+	})
+}
+
+// newFloatConverter function creates a new Converter for the given floating-point type.
+func newFloatConverter[T float32 | float64]() Converter {
+	return NewConverter(func(value any) (T, error) {
+		var result T
+
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(toText(value)), 64)
+		if err != nil {
+			return 0, err
+		}
+
+		result = T(parsed)
+		if math.IsInf(float64(result), 0) && !math.IsInf(parsed, 0) {
+			return 0, fmt.Errorf("value %v overflows %T", parsed, result)
+		}
+
+		return result, nil
+	})
+}
+
+// toString function returns the string form of the given value.
+func toString(value any) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	return fmt.Sprint(value), nil
+}
+
+// toBool function converts the given value to a boolean.
+// Besides the values accepted by strconv.ParseBool, 'yes', 'on', 'no' and 'off' are accepted.
+func toBool(value any) (bool, error) {
+	if typed, ok := value.(bool); ok {
+		return typed, nil
+	}
+
+	text := strings.ToLower(strings.TrimSpace(toText(value)))
+	switch text {
+	case "yes", "on":
+		return true, nil
+	case "no", "off":
+		return false, nil
+	}
+
+	return strconv.ParseBool(text)
+}
+
+// toInt function converts the given value to an integer.
+func toInt(value any) (int64, error) {
+	switch typed := value.(type) {
+	case int:
+		return int64(typed), nil
+	case int8:
+		return int64(typed), nil
+	case int16:
+		return int64(typed), nil
+	case int32:
+		return int64(typed), nil
+	case int64:
+		return typed, nil
+	case uint:
+		return toInt(uint64(typed))
+	case uint8:
+		return int64(typed), nil
+	case uint16:
+		return int64(typed), nil
+	case uint32:
+		return int64(typed), nil
+	case uint64:
+		if typed > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows int64", typed)
+		}
+
+		return int64(typed), nil
+	case float32:
+		return toInt(float64(typed))
+	case float64:
+		if typed != math.Trunc(typed) {
+			return 0, fmt.Errorf("value %v is not an integer", typed)
+		}
+
+		return int64(typed), nil
+	default:
+		return strconv.ParseInt(strings.TrimSpace(toText(value)), 10, 64)
+	}
+}
+
+// toDuration function converts the given value to a duration. Besides the units accepted by
+// time.ParseDuration, the 'd' unit is accepted for days. The values without units are considered as milliseconds.
+func toDuration(value any) (time.Duration, error) {
+	if millis, err := toInt(value); err == nil {
+		return time.Duration(millis) * time.Millisecond, nil
+	}
+
+	text := strings.TrimSpace(toText(value))
+	if days, ok := strings.CutSuffix(text, "d"); ok {
+		if amount, err := strconv.ParseInt(days, 10, 64); err == nil {
+			return time.Duration(amount) * 24 * time.Hour, nil
+		}
+	}
+
+	return time.ParseDuration(text)
+}
+
+// toDataSize function converts the given value to a data size.
+func toDataSize(value any) (DataSize, error) {
+	if bytes, err := toInt(value); err == nil {
+		return DataSize(bytes), nil
+	}
+
+	return ParseDataSize(toText(value))
+}
+
+// toTime function converts the given value to a time. The strings are parsed by the layouts
+// in timeLayouts and the integers are considered as Unix timestamps in seconds.
+func toTime(value any) (time.Time, error) {
+	if seconds, err := toInt(value); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+
+	text := strings.TrimSpace(toText(value))
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time '%s'", text)
+}
+
+// toURL function converts the given value to a URL.
+func toURL(value any) (url.URL, error) {
+	parsed, err := toURLPointer(value)
+	if err != nil {
+		return url.URL{}, err
+	}
+
+	return *parsed, nil
+}
+
+// toURLPointer function converts the given value to a URL pointer.
+func toURLPointer(value any) (*url.URL, error) {
+	return url.Parse(strings.TrimSpace(toText(value)))
+}
+
+// toText function returns the string form of the given value.
+func toText(value any) string {
+	text, _ := toString(value)
+	return text
+}
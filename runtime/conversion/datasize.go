@@ -0,0 +1,68 @@
+package conversion
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DataSize represents a size of data in bytes, such as '512KB' or '10MB'.
+type DataSize int64
+
+const (
+	Byte     DataSize = 1
+	Kilobyte          = 1024 * Byte
+	Megabyte          = 1024 * Kilobyte
+	Gigabyte          = 1024 * Megabyte
+	Terabyte          = 1024 * Gigabyte
+)
+
+// dataSizeUnits holds the supported data size units, from the longest suffix to the shortest.
+var dataSizeUnits = []struct {
+	suffix string
+	size   DataSize
+}{
+	{"TB", Terabyte},
+	{"GB", Gigabyte},
+	{"MB", Megabyte},
+	{"KB", Kilobyte},
+	{"B", Byte},
+}
+
+// ParseDataSize function parses the given text as a data size. The supported units are
+// B, KB, MB, GB and TB, and the values without units are considered as bytes.
+func ParseDataSize(text string) (DataSize, error) {
+	value := strings.ToUpper(strings.TrimSpace(text))
+	unit := Byte
+
+	for _, dataSizeUnit := range dataSizeUnits {
+		if strings.HasSuffix(value, dataSizeUnit.suffix) {
+			value = strings.TrimSpace(strings.TrimSuffix(value, dataSizeUnit.suffix))
+			unit = dataSizeUnit.size
+			break
+		}
+	}
+
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid data size '%s'", text)
+	}
+
+	return DataSize(amount) * unit, nil
+}
+
+// Bytes method returns the data size in bytes.
+func (d DataSize) Bytes() int64 {
+	return int64(d)
+}
+
+// String method returns the data size in the largest unit which represents it exactly.
+func (d DataSize) String() string {
+	for _, dataSizeUnit := range dataSizeUnits {
+		if d != 0 && d%dataSizeUnit.size == 0 {
+			return strconv.FormatInt(int64(d/dataSizeUnit.size), 10) + dataSizeUnit.suffix
+		}
+	}
+
+	return strconv.FormatInt(int64(d), 10) + "B"
+}
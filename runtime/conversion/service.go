@@ -0,0 +1,284 @@
+package conversion
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Service interface provides methods for converting values to other types.
+type Service interface {
+	// Convert converts the given value to the given target type.
+	Convert(value any, targetType reflect.Type) (any, error)
+	// RegisterConverter registers the given converter for its target type.
+	// A converter replaces the converter previously registered for the same target type.
+	RegisterConverter(converter Converter)
+	// HasConverter checks if there is a converter registered for the given target type.
+	HasConverter(targetType reflect.Type) bool
+}
+
+// Convert function converts the given value to the type T by using the given service.
+func Convert[T any](service Service, value any) (T, error) {
+	var zero T
+	if service == nil {
+		return zero, fmt.Errorf("nil service")
+	}
+
+	result, err := service.Convert(value, reflect.TypeFor[T]())
+	if err != nil {
+		return zero, err
+	}
+
+	if result == nil {
+		return zero, nil
+	}
+
+	return result.(T), nil
+}
+
+// DefaultService struct is the default implementation of the Service interface.
+// It converts strings and scalars such as the ones read from YAML files into numbers, booleans,
+// durations, data sizes, times and URLs by the registered converters. Slices are converted from lists
+// and comma-separated strings, maps are converted from maps by converting their keys and values,
+// pointers are converted by converting their elements, and the types implementing encoding.TextUnmarshaler
+// are converted by unmarshalling the string form of the values.
+type DefaultService struct {
+	converters map[reflect.Type]Converter
+	mu         sync.RWMutex
+}
+
+// NewDefaultService function creates a new DefaultService with the default converters.
+func NewDefaultService() *DefaultService {
+	service := &DefaultService{
+		converters: make(map[reflect.Type]Converter),
+	}
+
+	for _, converter := range defaultConverters() {
+		service.RegisterConverter(converter)
+	}
+
+	return service
+}
+
+// RegisterConverter method registers the given converter for its target type.
+func (s *DefaultService) RegisterConverter(converter Converter) {
+	if converter == nil {
+		panic("nil converter")
+	}
+
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	s.converters[converter.TargetType()] = converter
+}
+
+// HasConverter method checks if there is a converter registered for the given target type.
+func (s *DefaultService) HasConverter(targetType reflect.Type) bool {
+	_, ok := s.converter(targetType)
+	return ok
+}
+
+// converter method returns the converter registered for the given target type.
+func (s *DefaultService) converter(targetType reflect.Type) (Converter, bool) {
+	defer s.mu.RUnlock()
+	s.mu.RLock()
+
+	converter, ok := s.converters[targetType]
+	return converter, ok
+}
+
+// Convert method converts the given value to the given target type.
+// It returns an error if the value cannot be converted.
+func (s *DefaultService) Convert(value any, targetType reflect.Type) (any, error) {
+	if targetType == nil {
+		return nil, fmt.Errorf("nil target type")
+	}
+
+	result, err := s.convert(value, targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Interface(), nil
+}
+
+// convert method converts the given value to the given target type and returns it as a reflect.Value.
+func (s *DefaultService) convert(value any, targetType reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(targetType), nil
+	}
+
+	valueType := reflect.TypeOf(value)
+	if valueType == targetType || (targetType.Kind() == reflect.Interface && valueType.Implements(targetType)) {
+		return reflect.ValueOf(value), nil
+	}
+
+	if converter, ok := s.converter(targetType); ok {
+		return s.convertBy(converter, value, targetType)
+	}
+
+	if reflect.PointerTo(targetType).Implements(textUnmarshalerType) {
+		result := reflect.New(targetType)
+		err := result.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(toText(value)))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return result.Elem(), nil
+	}
+
+	switch targetType.Kind() {
+	case reflect.Pointer:
+		elem, err := s.convert(value, targetType.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result := reflect.New(targetType.Elem())
+		result.Elem().Set(elem)
+		return result, nil
+	case reflect.Slice:
+		if targetType.Elem().Kind() == reflect.Uint8 && valueType.Kind() == reflect.String {
+			return reflect.ValueOf([]byte(toText(value))).Convert(targetType), nil
+		}
+
+		return s.convertSlice(value, targetType)
+	case reflect.Map:
+		return s.convertMap(value, targetType)
+	}
+
+	if kindType, ok := kindTypes[targetType.Kind()]; ok {
+		if converter, exists := s.converter(kindType); exists {
+			return s.convertBy(converter, value, targetType)
+		}
+	}
+
+	if valueType.ConvertibleTo(targetType) {
+		return reflect.ValueOf(value).Convert(targetType), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot convert %T to %s", value, targetType)
+}
+
+// convertBy method converts the given value by the given converter. If the type of the converted value
+// is different from the target type, such as a named type of a basic type, it is converted to the target type.
+func (s *DefaultService) convertBy(converter Converter, value any, targetType reflect.Type) (reflect.Value, error) {
+	converted, err := converter.Convert(value)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if converted == nil {
+		return reflect.Zero(targetType), nil
+	}
+
+	result := reflect.ValueOf(converted)
+	if result.Type() != targetType {
+		if !result.Type().ConvertibleTo(targetType) {
+			return reflect.Value{}, fmt.Errorf("cannot convert %T to %s", converted, targetType)
+		}
+
+		result = result.Convert(targetType)
+	}
+
+	return result, nil
+}
+
+// convertSlice method converts the given list or comma-separated string to the given slice type.
+func (s *DefaultService) convertSlice(value any, targetType reflect.Type) (reflect.Value, error) {
+	items := SplitList(value)
+	result := reflect.MakeSlice(targetType, 0, len(items))
+
+	for index, item := range items {
+		converted, err := s.convert(item, targetType.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("cannot convert item at index %d: %w", index, err)
+		}
+
+		result = reflect.Append(result, converted)
+	}
+
+	return result, nil
+}
+
+// convertMap method converts the given map to the given map type by converting its keys and values.
+func (s *DefaultService) convertMap(value any, targetType reflect.Type) (reflect.Value, error) {
+	source := reflect.ValueOf(value)
+	if source.Kind() != reflect.Map {
+		return reflect.Value{}, fmt.Errorf("cannot convert %T to %s", value, targetType)
+	}
+
+	result := reflect.MakeMapWithSize(targetType, source.Len())
+	iterator := source.MapRange()
+
+	for iterator.Next() {
+		key, err := s.convert(iterator.Key().Interface(), targetType.Key())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("cannot convert key '%v': %w", iterator.Key(), err)
+		}
+
+		item, err := s.convert(iterator.Value().Interface(), targetType.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("cannot convert value of key '%v': %w", iterator.Key(), err)
+		}
+
+		result.SetMapIndex(key, item)
+	}
+
+	return result, nil
+}
+
+// SplitList function returns the items of the given list value. The slices are returned item by item,
+// the strings are split into their comma-separated parts and the other values are returned as single items.
+func SplitList(value any) []any {
+	if str, ok := value.(string); ok {
+		parts := make([]any, 0)
+
+		if strings.TrimSpace(str) == "" {
+			return parts
+		}
+
+		for _, part := range strings.Split(str, ",") {
+			parts = append(parts, strings.TrimSpace(part))
+		}
+
+		return parts
+	}
+
+	list := reflect.ValueOf(value)
+	if list.Kind() == reflect.Slice || list.Kind() == reflect.Array {
+		items := make([]any, 0, list.Len())
+
+		for index := 0; index < list.Len(); index++ {
+			items = append(items, list.Index(index).Interface())
+		}
+
+		return items
+	}
+
+	return []any{value}
+}
+
+// textUnmarshalerType is the type of the encoding.TextUnmarshaler interface.
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// kindTypes maps the basic kinds to their types, so that the named types of the basic types
+// are converted by the converters registered for the basic types.
+var kindTypes = map[reflect.Kind]reflect.Type{
+	reflect.String:  reflect.TypeFor[string](),
+	reflect.Bool:    reflect.TypeFor[bool](),
+	reflect.Int:     reflect.TypeFor[int](),
+	reflect.Int8:    reflect.TypeFor[int8](),
+	reflect.Int16:   reflect.TypeFor[int16](),
+	reflect.Int32:   reflect.TypeFor[int32](),
+	reflect.Int64:   reflect.TypeFor[int64](),
+	reflect.Uint:    reflect.TypeFor[uint](),
+	reflect.Uint8:   reflect.TypeFor[uint8](),
+	reflect.Uint16:  reflect.TypeFor[uint16](),
+	reflect.Uint32:  reflect.TypeFor[uint32](),
+	reflect.Uint64:  reflect.TypeFor[uint64](),
+	reflect.Float32: reflect.TypeFor[float32](),
+	reflect.Float64: reflect.TypeFor[float64](),
+}
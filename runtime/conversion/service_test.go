@@ -0,0 +1,113 @@
+package conversion
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type anyPort int
+
+type anyAddress struct {
+	Host string
+}
+
+func TestDefaultService_ConvertShouldConvertScalarValues(t *testing.T) {
+	service := NewDefaultService()
+
+	testCases := []struct {
+		value    any
+		expected any
+	}{
+		{"8080", 8080},
+		{8080, "8080"},
+		{"127", int8(127)},
+		{uint64(42), uint16(42)},
+		{"3.5", 3.5},
+		{"on", true},
+		{"false", false},
+		{"5s", 5 * time.Second},
+		{"2d", 48 * time.Hour},
+		{1500, 1500 * time.Millisecond},
+		{"10MB", 10 * Megabyte},
+		{"2024-01-02", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"8080", anyPort(8080)},
+	}
+
+	for _, testCase := range testCases {
+		result, err := service.Convert(testCase.value, reflect.TypeOf(testCase.expected))
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expected, result)
+	}
+}
+
+func TestDefaultService_ConvertShouldConvertURLs(t *testing.T) {
+	service := NewDefaultService()
+
+	result, err := Convert[*url.URL](service, "https://codnect.io/procyon")
+	assert.Nil(t, err)
+	assert.Equal(t, "codnect.io", result.Host)
+	assert.Equal(t, "/procyon", result.Path)
+}
+
+func TestDefaultService_ConvertShouldConvertListsAndCommaSeparatedStringsToSlices(t *testing.T) {
+	service := NewDefaultService()
+
+	result, err := Convert[[]int](service, "1, 2,3")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+
+	result, err = Convert[[]int](service, []any{"4", 5})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{4, 5}, result)
+}
+
+func TestDefaultService_ConvertShouldConvertMapKeysAndValues(t *testing.T) {
+	service := NewDefaultService()
+
+	result, err := Convert[map[string]time.Duration](service, map[any]any{"read": "1s", "write": 2000})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]time.Duration{"read": time.Second, "write": 2 * time.Second}, result)
+}
+
+func TestDefaultService_ConvertShouldReturnErrorIfValueOverflowsTargetType(t *testing.T) {
+	service := NewDefaultService()
+
+	_, err := Convert[int8](service, "300")
+	assert.EqualError(t, err, "value 300 overflows int8")
+}
+
+func TestDefaultService_ConvertShouldReturnErrorIfValueCannotBeConverted(t *testing.T) {
+	service := NewDefaultService()
+
+	_, err := Convert[anyAddress](service, 8080)
+	assert.EqualError(t, err, "cannot convert int to conversion.anyAddress")
+}
+
+func TestDefaultService_ConvertShouldUseRegisteredConverters(t *testing.T) {
+	service := NewDefaultService()
+	service.RegisterConverter(NewConverter(func(value any) (anyAddress, error) {
+		return anyAddress{Host: value.(string)}, nil
+	}))
+
+	result, err := Convert[[]anyAddress](service, "localhost,codnect.io")
+	assert.Nil(t, err)
+	assert.Equal(t, []anyAddress{{Host: "localhost"}, {Host: "codnect.io"}}, result)
+}
+
+func TestParseDataSize_ShouldParseDataSizesWithUnits(t *testing.T) {
+	size, err := ParseDataSize("512KB")
+	assert.Nil(t, err)
+	assert.Equal(t, 512*Kilobyte, size)
+	assert.Equal(t, "512KB", size.String())
+
+	size, err = ParseDataSize("1024")
+	assert.Nil(t, err)
+	assert.Equal(t, "1KB", size.String())
+
+	_, err = ParseDataSize("tenMB")
+	assert.EqualError(t, err, "invalid data size 'tenMB'")
+}
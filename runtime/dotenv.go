@@ -0,0 +1,245 @@
+package runtime
+
+import (
+	"codnect.io/procyon-core/runtime/property"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DotenvFileName is the name of the dotenv file.
+	DotenvFileName = ".env"
+)
+
+// DotenvSource struct represents a source of the variables loaded from a dotenv file.
+// The property names are mapped to the variable names in the same relaxed way as the EnvironmentSource,
+// so that the 'procyon.server.port' property is resolved from the 'PROCYON_SERVER_PORT' variable.
+// The origins of the properties are reported by the lines of their variables if the source is loaded from a file.
+type DotenvSource struct {
+	*EnvironmentSource
+	name  string
+	path  string
+	lines map[string]int
+}
+
+// NewDotenvSource function creates a new DotenvSource with the given name and variables.
+func NewDotenvSource(name string, variables map[string]string) *DotenvSource {
+	if strings.TrimSpace(name) == "" {
+		panic("cannot create dotenv source with empty or blank name")
+	}
+
+	if variables == nil {
+		panic("nil variables")
+	}
+
+	return &DotenvSource{
+		EnvironmentSource: &EnvironmentSource{
+			variables: variables,
+		},
+		name: name,
+	}
+}
+
+// LoadDotenvSource function loads the dotenv file at the given path as a DotenvSource.
+// The name of the source is the base name of the file, such as '.env' or '.env.dev'.
+func LoadDotenvSource(path string) (*DotenvSource, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, lines, err := parseDotenv(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dotenv file '%s': %w", path, err)
+	}
+
+	source := NewDotenvSource(filepath.Base(path), variables)
+	source.path = path
+	source.lines = lines
+	return source, nil
+}
+
+// Name method returns the name of the source.
+func (s *DotenvSource) Name() string {
+	return s.name
+}
+
+// PropertyOrigin method returns the origin of the property with the given name, which is the line of its variable
+// in the dotenv file. If the source is not loaded from a file, the origin is the name of the variable.
+func (s *DotenvSource) PropertyOrigin(name string) (property.Origin, bool) {
+	variableName, exists := s.variableName(name)
+
+	if !exists {
+		return property.Origin{}, false
+	}
+
+	if line, ok := s.lines[variableName]; ok {
+		return property.Origin{
+			Source:   s.name,
+			Location: s.path,
+			Line:     line,
+		}, true
+	}
+
+	return property.Origin{
+		Source:   s.name,
+		Location: fmt.Sprintf("variable '%s'", variableName),
+	}, true
+}
+
+// ParseDotenv function parses the given content in the dotenv format. The lines may start with
+// the 'export' keyword, and the comments start with '#'. The single-quoted values are taken literally,
+// the double-quoted values may span multiple lines and contain escape sequences, and the unquoted values
+// end at the inline comments. The references such as '${VAR}', '${VAR:-default}' and '$VAR' in the double-quoted
+// and unquoted values are expanded from the variables defined before and the environment variables.
+func ParseDotenv(content string) (map[string]string, error) {
+	variables, _, err := parseDotenv(content)
+	return variables, err
+}
+
+// parseDotenv function parses the given content in the dotenv format,
+// and returns the variables together with the lines in which they are defined.
+func parseDotenv(content string) (map[string]string, map[string]int, error) {
+	variables := make(map[string]string)
+	variableLines := make(map[string]int)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for index := 0; index < len(lines); index++ {
+		lineNumber := index + 1
+		line := strings.TrimSpace(lines[index])
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, nil, fmt.Errorf("invalid line %d: '%s'", lineNumber, lines[index])
+		}
+
+		value = strings.TrimSpace(value)
+		variableLines[key] = lineNumber
+
+		switch {
+		case strings.HasPrefix(value, "'"):
+			end := strings.Index(value[1:], "'")
+			if end == -1 {
+				return nil, nil, fmt.Errorf("unterminated single-quoted value at line %d", lineNumber)
+			}
+
+			variables[key] = value[1 : end+1]
+		case strings.HasPrefix(value, "\""):
+			quoted := value[1:]
+			end := findClosingQuote(quoted)
+
+			for end == -1 && index+1 < len(lines) {
+				index++
+				quoted += "\n" + lines[index]
+				end = findClosingQuote(quoted)
+			}
+
+			if end == -1 {
+				return nil, nil, fmt.Errorf("unterminated double-quoted value at line %d", lineNumber)
+			}
+
+			variables[key] = expandDotenv(unescapeDotenv(quoted[:end]), variables)
+		default:
+			if commentIndex := strings.Index(value, " #"); commentIndex != -1 {
+				value = strings.TrimSpace(value[:commentIndex])
+			}
+
+			variables[key] = expandDotenv(value, variables)
+		}
+	}
+
+	return variables, variableLines, nil
+}
+
+// findClosingQuote function returns the index of the first unescaped double quote in the given text.
+func findClosingQuote(text string) int {
+	for index := 0; index < len(text); index++ {
+		switch text[index] {
+		case '\\':
+			index++
+		case '"':
+			return index
+		}
+	}
+
+	return -1
+}
+
+// unescapeDotenv function replaces the escape sequences in the given double-quoted value.
+// The escaped dollar signs are kept escaped, so that they are not expanded.
+func unescapeDotenv(text string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\r`, "\r", `\t`, "\t", `\"`, `"`, `\\`, `\`)
+	return replacer.Replace(text)
+}
+
+// expandDotenv function expands the variable references in the given value.
+// A dollar sign prefixed with a backslash is not expanded and the backslash is removed.
+func expandDotenv(value string, variables map[string]string) string {
+	if !strings.Contains(value, "$") {
+		return value
+	}
+
+	lookup := func(name string) (string, bool) {
+		if variable, ok := variables[name]; ok {
+			return variable, true
+		}
+
+		return os.LookupEnv(name)
+	}
+
+	var builder strings.Builder
+	for index := 0; index < len(value); index++ {
+		switch {
+		case value[index] == '\\' && index+1 < len(value) && value[index+1] == '$':
+			builder.WriteByte('$')
+			index++
+		case value[index] == '$' && index+1 < len(value) && value[index+1] == '{':
+			end := strings.IndexByte(value[index:], '}')
+			if end == -1 {
+				builder.WriteString(value[index:])
+				return builder.String()
+			}
+
+			name, defaultValue, hasDefault := strings.Cut(value[index+2:index+end], ":-")
+			if variable, ok := lookup(name); ok && (variable != "" || !hasDefault) {
+				builder.WriteString(variable)
+			} else {
+				builder.WriteString(defaultValue)
+			}
+
+			index += end
+		case value[index] == '$':
+			end := index + 1
+			for end < len(value) && isVariableNameChar(value[end]) {
+				end++
+			}
+
+			if end == index+1 {
+				builder.WriteByte('$')
+				continue
+			}
+
+			variable, _ := lookup(value[index+1 : end])
+			builder.WriteString(variable)
+			index = end - 1
+		default:
+			builder.WriteByte(value[index])
+		}
+	}
+
+	return builder.String()
+}
+
+// isVariableNameChar function checks if the given character can be used in a variable name.
+func isVariableNameChar(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
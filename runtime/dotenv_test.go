@@ -0,0 +1,84 @@
+package runtime
+
+import (
+	"codnect.io/procyon-core/runtime/property"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseDotenv_ShouldParseVariables(t *testing.T) {
+	t.Setenv("ANY_DOTENV_HOST", "localhost")
+
+	content := `# comment
+PROCYON_SERVER_PORT=8080
+export PROCYON_APPLICATION_NAME = anyApplication # inline comment
+SINGLE_QUOTED='${ANY_DOTENV_HOST} # not a comment'
+DOUBLE_QUOTED="line1\nline2 \"quoted\""
+MULTILINE="first
+second"
+DB_URL=jdbc://${ANY_DOTENV_HOST}:$PROCYON_SERVER_PORT
+DB_USER=${MISSING_DOTENV_USER:-root}
+ESCAPED="\${ANY_DOTENV_HOST}"
+EMPTY=
+`
+
+	variables, err := ParseDotenv(content)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"PROCYON_SERVER_PORT":      "8080",
+		"PROCYON_APPLICATION_NAME": "anyApplication",
+		"SINGLE_QUOTED":            "${ANY_DOTENV_HOST} # not a comment",
+		"DOUBLE_QUOTED":            "line1\nline2 \"quoted\"",
+		"MULTILINE":                "first\nsecond",
+		"DB_URL":                   "jdbc://localhost:8080",
+		"DB_USER":                  "root",
+		"ESCAPED":                  "${ANY_DOTENV_HOST}",
+		"EMPTY":                    "",
+	}, variables)
+}
+
+func TestParseDotenv_ShouldReturnErrorIfLineIsInvalid(t *testing.T) {
+	_, err := ParseDotenv("PROCYON_SERVER_PORT")
+	assert.EqualError(t, err, "invalid line 1: 'PROCYON_SERVER_PORT'")
+
+	_, err = ParseDotenv("PROCYON_SERVER_PORT=\"8080")
+	assert.EqualError(t, err, "unterminated double-quoted value at line 1")
+}
+
+func TestLoadDotenvSource_ShouldMapPropertyNamesToVariables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env.dev")
+	assert.Nil(t, os.WriteFile(path, []byte("PROCYON_SERVER_PORT=8080\nprocyon_max_idle=5\n"), 0600))
+
+	source, err := LoadDotenvSource(path)
+	assert.Nil(t, err)
+	assert.Equal(t, ".env.dev", source.Name())
+
+	value, ok := source.Property("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, "8080", value)
+
+	value, ok = source.Property("procyon.max-idle")
+	assert.True(t, ok)
+	assert.Equal(t, "5", value)
+
+	_, err = LoadDotenvSource(filepath.Join(t.TempDir(), ".env"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadDotenvSource_PropertyOriginShouldReturnLineOfVariable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	assert.Nil(t, os.WriteFile(path, []byte("# comment\nPROCYON_SERVER_PORT=8080\n"), 0600))
+
+	source, err := LoadDotenvSource(path)
+	assert.Nil(t, err)
+
+	origin, ok := source.PropertyOrigin("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, property.Origin{Source: ".env", Location: path, Line: 2}, origin)
+
+	origin, ok = NewDotenvSource(".env", map[string]string{"PROCYON_SERVER_PORT": "8080"}).PropertyOrigin("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, property.Origin{Source: ".env", Location: "variable 'PROCYON_SERVER_PORT'"}, origin)
+}
@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"codnect.io/procyon-core/runtime/conversion"
 	"codnect.io/procyon-core/runtime/property"
 	"fmt"
 	"strings"
@@ -67,8 +68,12 @@ func (e *DefaultEnvironment) doGetActiveProfiles() {
 		propertyValue, ok := e.PropertyResolver().Property("procyon.profiles.active")
 
 		if ok {
-			activeProfiles := strings.Split(strings.TrimSpace(propertyValue.(string)), ",")
-			err := e.SetActiveProfiles(activeProfiles...)
+			activeProfiles, err := conversion.Convert[[]string](property.ConversionServiceOf(e.PropertyResolver()), propertyValue)
+			if err != nil {
+				panic(err)
+			}
+
+			err = e.SetActiveProfiles(activeProfiles...)
 
 			if err != nil {
 				panic(err)
@@ -98,8 +103,12 @@ func (e *DefaultEnvironment) doGetDefaultProfiles() {
 		propertyValue, ok := e.PropertyResolver().Property("procyon.profiles.default")
 
 		if ok {
-			defaultProfiles := strings.Split(strings.TrimSpace(propertyValue.(string)), ",")
-			err := e.SetDefaultProfiles(defaultProfiles...)
+			defaultProfiles, err := conversion.Convert[[]string](property.ConversionServiceOf(e.PropertyResolver()), propertyValue)
+			if err != nil {
+				panic(err)
+			}
+
+			err = e.SetDefaultProfiles(defaultProfiles...)
 
 			if err != nil {
 				panic(err)
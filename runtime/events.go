@@ -1,6 +1,9 @@
 package runtime
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // StartupEvent struct represents an event that occurs when the application starts up.
 type StartupEvent struct {
@@ -59,3 +62,41 @@ func (s ShutdownEvent) EventSource() any {
 func (s ShutdownEvent) EventTime() time.Time {
 	return s.time
 }
+
+// EnvironmentChangedEvent struct represents an event that occurs when the properties of the environment change,
+// such as when a config file is reloaded.
+type EnvironmentChangedEvent struct {
+	environment Environment
+	keys        []string
+	time        time.Time
+}
+
+// NewEnvironmentChangedEvent function creates a new EnvironmentChangedEvent with the given environment
+// and the names of the changed properties.
+func NewEnvironmentChangedEvent(environment Environment, keys []string) EnvironmentChangedEvent {
+	return EnvironmentChangedEvent{
+		environment: environment,
+		keys:        slices.Clone(keys),
+		time:        time.Now(),
+	}
+}
+
+// Environment method returns the changed environment.
+func (e EnvironmentChangedEvent) Environment() Environment {
+	return e.environment
+}
+
+// Keys method returns the names of the changed properties.
+func (e EnvironmentChangedEvent) Keys() []string {
+	return slices.Clone(e.keys)
+}
+
+// EventSource method returns the source of the event, which is the environment.
+func (e EnvironmentChangedEvent) EventSource() any {
+	return e.environment
+}
+
+// EventTime method returns the time when the event occurred.
+func (e EnvironmentChangedEvent) EventTime() time.Time {
+	return e.time
+}
@@ -1,8 +1,16 @@
 package runtime
 
 import (
+	"cmp"
+	"codnect.io/procyon-core/component/container"
+	"codnect.io/procyon-core/component/filter"
 	"codnect.io/procyon-core/runtime/property"
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -13,11 +21,219 @@ type Lifecycle interface {
 	IsRunning() bool
 }
 
+// Phased interface is implemented by the lifecycle components which declare the phase they take part in.
+// Components are started in ascending phase order and stopped in descending phase order.
+type Phased interface {
+	// Phase method returns the phase of the component.
+	Phase() int
+}
+
+// AutoStartup interface is implemented by the lifecycle components which decide
+// whether they should be started automatically.
+type AutoStartup interface {
+	// IsAutoStartup method checks if the component should be started automatically.
+	IsAutoStartup() bool
+}
+
+// LifecycleProcessor interface provides methods for starting and stopping the lifecycle components.
+type LifecycleProcessor interface {
+	// OnStart method starts the lifecycle components.
+	OnStart(ctx context.Context) error
+	// OnStop method stops the lifecycle components.
+	OnStop(ctx context.Context) error
+}
+
+// lifecyclePhase struct represents the lifecycle components in the same phase.
+type lifecyclePhase struct {
+	phase      int
+	lifecycles []Lifecycle
+}
+
+// DefaultLifecycleProcessor struct is the default implementation of the LifecycleProcessor interface.
+// It discovers the Lifecycle components in the container. The phase of a component is resolved from
+// the Phased interface if it is implemented, otherwise the priority of its definition is used.
+type DefaultLifecycleProcessor struct {
+	container container.Container
+	phases    []lifecyclePhase
+	mu        sync.Mutex
+}
+
+// NewDefaultLifecycleProcessor function creates a new DefaultLifecycleProcessor with the given container.
+func NewDefaultLifecycleProcessor(container container.Container) *DefaultLifecycleProcessor {
+	if container == nil {
+		panic("nil container")
+	}
+
+	return &DefaultLifecycleProcessor{
+		container: container,
+	}
+}
+
+// OnStart method starts the lifecycle components in ascending phase order.
+// The components which are already running or are not auto startup are skipped.
+func (p *DefaultLifecycleProcessor) OnStart(ctx context.Context) error {
+	phases, err := p.lifecyclePhases(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, phase := range phases {
+		for _, lifecycle := range phase.lifecycles {
+			if lifecycle.IsRunning() {
+				continue
+			}
+
+			if autoStartup, ok := lifecycle.(AutoStartup); ok && !autoStartup.IsAutoStartup() {
+				continue
+			}
+
+			err = lifecycle.Start(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to start lifecycle component '%T' in phase %d: %w", lifecycle, phase.phase, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// OnStop method stops the running lifecycle components in descending phase order.
+// The components in the same phase are stopped concurrently.
+func (p *DefaultLifecycleProcessor) OnStop(ctx context.Context) error {
+	phases, err := p.lifecyclePhases(ctx)
+	if err != nil {
+		return err
+	}
+
+	for index := len(phases) - 1; index >= 0; index-- {
+		err = errors.Join(err, p.stopPhase(ctx, phases[index]))
+	}
+
+	return err
+}
+
+// stopPhase method stops the running lifecycle components in the given phase concurrently
+// and waits until they are stopped or the context is done.
+func (p *DefaultLifecycleProcessor) stopPhase(ctx context.Context, phase lifecyclePhase) error {
+	stopErrs := make(chan error, len(phase.lifecycles))
+	count := 0
+
+	for _, lifecycle := range phase.lifecycles {
+		if !lifecycle.IsRunning() {
+			continue
+		}
+
+		count++
+		go func() {
+			stopErr := lifecycle.Stop(ctx)
+			if stopErr != nil {
+				stopErr = fmt.Errorf("failed to stop lifecycle component '%T' in phase %d: %w", lifecycle, phase.phase, stopErr)
+			}
+
+			stopErrs <- stopErr
+		}()
+	}
+
+	var err error
+	for ; count > 0; count-- {
+		select {
+		case stopErr := <-stopErrs:
+			err = errors.Join(err, stopErr)
+		case <-ctx.Done():
+			return errors.Join(err, fmt.Errorf("%d lifecycle component(s) in phase %d could not be stopped in time", count, phase.phase))
+		}
+	}
+
+	return err
+}
+
+// lifecyclePhases method returns the lifecycle components grouped by their phases in ascending phase order.
+// The components in the same phase are ordered by their names, so that they are started in the same order
+// on every run. The components are discovered from the container only once.
+func (p *DefaultLifecycleProcessor) lifecyclePhases(ctx context.Context) ([]lifecyclePhase, error) {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	if p.phases != nil {
+		return p.phases, nil
+	}
+
+	components := make([]phasedLifecycle, 0)
+	names := make(map[string]struct{})
+
+	for _, definition := range p.container.Definitions().List(filter.ByTypeOf[Lifecycle]()) {
+		object, err := p.container.GetObject(ctx, filter.ByName(definition.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		names[definition.Name()] = struct{}{}
+		components = append(components, newPhasedLifecycle(definition.Name(), definition.Priority(), object.(Lifecycle)))
+	}
+
+	for _, name := range p.container.Singletons().Names() {
+		if _, exists := names[name]; exists {
+			continue
+		}
+
+		object, ok := p.container.Singletons().FindFirst(filter.ByName(name), filter.ByTypeOf[Lifecycle]())
+		if !ok {
+			continue
+		}
+
+		components = append(components, newPhasedLifecycle(name, 0, object.(Lifecycle)))
+	}
+
+	slices.SortFunc(components, func(a, b phasedLifecycle) int {
+		if a.phase != b.phase {
+			return cmp.Compare(a.phase, b.phase)
+		}
+
+		return strings.Compare(a.name, b.name)
+	})
+
+	p.phases = make([]lifecyclePhase, 0)
+	for _, component := range components {
+		if len(p.phases) == 0 || p.phases[len(p.phases)-1].phase != component.phase {
+			p.phases = append(p.phases, lifecyclePhase{
+				phase: component.phase,
+			})
+		}
+
+		last := &p.phases[len(p.phases)-1]
+		last.lifecycles = append(last.lifecycles, component.lifecycle)
+	}
+
+	return p.phases, nil
+}
+
+// phasedLifecycle struct represents a lifecycle component together with its name and phase.
+type phasedLifecycle struct {
+	name      string
+	phase     int
+	lifecycle Lifecycle
+}
+
+// newPhasedLifecycle function creates a new phasedLifecycle. The phase is resolved from the Phased interface
+// if the component implements it, otherwise the given default phase is used.
+func newPhasedLifecycle(name string, defaultPhase int, lifecycle Lifecycle) phasedLifecycle {
+	phase := defaultPhase
+	if phased, ok := lifecycle.(Phased); ok {
+		phase = phased.Phase()
+	}
+
+	return phasedLifecycle{
+		name:      name,
+		phase:     phase,
+		lifecycle: lifecycle,
+	}
+}
+
 // LifecycleProperties struct represents the properties of application lifecycle.
 type LifecycleProperties struct {
 	property.Properties `prefix:"procyon.lifecycle"`
 
-	ShutdownTimeout time.Duration `prop:"shutdown-timeout" default:"30000"`
+	ShutdownTimeout time.Duration `prop:"shutdown-timeout" default:"30000" validate:"min=0"`
 }
 
 // NewLifecycleProperties function creates a new LifecycleProperties.
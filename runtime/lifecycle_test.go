@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"codnect.io/procyon-core/component/container"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type anyLifecycle struct {
+	name        string
+	phase       int
+	autoStartup bool
+	running     bool
+	events      *[]string
+	mu          *sync.Mutex
+}
+
+func (l *anyLifecycle) Start(ctx context.Context) error {
+	l.running = true
+	l.record("start " + l.name)
+	return nil
+}
+
+func (l *anyLifecycle) Stop(ctx context.Context) error {
+	l.running = false
+	l.record("stop " + l.name)
+	return nil
+}
+
+func (l *anyLifecycle) IsRunning() bool {
+	return l.running
+}
+
+func (l *anyLifecycle) Phase() int {
+	return l.phase
+}
+
+func (l *anyLifecycle) IsAutoStartup() bool {
+	return l.autoStartup
+}
+
+func (l *anyLifecycle) record(event string) {
+	defer l.mu.Unlock()
+	l.mu.Lock()
+	*l.events = append(*l.events, event)
+}
+
+func TestDefaultLifecycleProcessor_OnStartShouldStartComponentsInAscendingPhaseOrder(t *testing.T) {
+	events := make([]string, 0)
+	mu := &sync.Mutex{}
+
+	objectContainer := container.New()
+	_ = objectContainer.Singletons().Register("consumer", &anyLifecycle{name: "consumer", phase: 10, autoStartup: true, events: &events, mu: mu})
+	_ = objectContainer.Singletons().Register("pool", &anyLifecycle{name: "pool", phase: -10, autoStartup: true, events: &events, mu: mu})
+	_ = objectContainer.Singletons().Register("manual", &anyLifecycle{name: "manual", autoStartup: false, events: &events, mu: mu})
+
+	processor := NewDefaultLifecycleProcessor(objectContainer)
+	err := processor.OnStart(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"start pool", "start consumer"}, events)
+}
+
+func TestDefaultLifecycleProcessor_OnStopShouldStopRunningComponentsInDescendingPhaseOrder(t *testing.T) {
+	events := make([]string, 0)
+	mu := &sync.Mutex{}
+
+	objectContainer := container.New()
+	_ = objectContainer.Singletons().Register("consumer", &anyLifecycle{name: "consumer", phase: 10, autoStartup: true, events: &events, mu: mu})
+	_ = objectContainer.Singletons().Register("pool", &anyLifecycle{name: "pool", phase: -10, autoStartup: true, events: &events, mu: mu})
+	_ = objectContainer.Singletons().Register("manual", &anyLifecycle{name: "manual", autoStartup: false, events: &events, mu: mu})
+
+	processor := NewDefaultLifecycleProcessor(objectContainer)
+	err := processor.OnStart(context.Background())
+	assert.Nil(t, err)
+
+	err = processor.OnStop(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"start pool", "start consumer", "stop consumer", "stop pool"}, events)
+}
+
+func TestDefaultLifecycleProcessor_OnStartShouldStartComponentsInSamePhaseInNameOrder(t *testing.T) {
+	events := make([]string, 0)
+	mu := &sync.Mutex{}
+
+	objectContainer := container.New()
+	for _, name := range []string{"delta", "alpha", "charlie", "bravo"} {
+		_ = objectContainer.Singletons().Register(name, &anyLifecycle{name: name, autoStartup: true, events: &events, mu: mu})
+	}
+
+	_ = objectContainer.Singletons().Register("early", &anyLifecycle{name: "early", phase: -1, autoStartup: true, events: &events, mu: mu})
+
+	processor := NewDefaultLifecycleProcessor(objectContainer)
+	err := processor.OnStart(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"start early", "start alpha", "start bravo", "start charlie", "start delta"}, events)
+}
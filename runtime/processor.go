@@ -0,0 +1,162 @@
+package runtime
+
+import (
+	"codnect.io/procyon-core/component/container"
+	"codnect.io/procyon-core/component/filter"
+	"codnect.io/procyon-core/runtime/event"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// PropertiesProcessor struct is an object processor which binds the properties of the environment
+// to the objects implementing the property.Properties interface before they are initialized.
+type PropertiesProcessor struct {
+	environment Environment
+}
+
+// NewPropertiesProcessor function creates a new PropertiesProcessor with the given environment.
+func NewPropertiesProcessor(environment Environment) *PropertiesProcessor {
+	if environment == nil {
+		panic("nil environment")
+	}
+
+	return &PropertiesProcessor{
+		environment: environment,
+	}
+}
+
+// ProcessBeforeInit method binds the properties to the given object if it implements the property.Properties interface,
+// and then validates the bound object by the validate tags of its fields.
+func (p *PropertiesProcessor) ProcessBeforeInit(ctx context.Context, object any) (any, error) {
+	if _, ok := object.(property.Properties); !ok {
+		return object, nil
+	}
+
+	binder := property.NewBinder(p.environment.PropertyResolver())
+	err := binder.Bind(object)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = property.Validate(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// ProcessAfterInit method returns the given object as it is.
+func (p *PropertiesProcessor) ProcessAfterInit(ctx context.Context, object any) (any, error) {
+	return object, nil
+}
+
+// PropertiesRebinder struct is an event listener which rebinds the properties of the environment to the singleton
+// objects implementing the property.Properties interface when an EnvironmentChangedEvent is published.
+// Each object is bound and validated on a new instance of its type, so that the removed properties fall back
+// to their default values, and the bound fields of the object are replaced only if the new instance is valid.
+// The objects are rebound on the goroutine publishing the event, while the other goroutines may read them.
+// Therefore, only the objects implementing sync.Locker, such as the ones embedding sync.RWMutex, are rebound.
+// They are locked while their fields are replaced, so that the readers holding the lock see either the old or
+// the new values. The other objects keep the values they were bound with when they were created.
+type PropertiesRebinder struct {
+	environment Environment
+	container   container.Container
+}
+
+// NewPropertiesRebinder function creates a new PropertiesRebinder with the given environment and container.
+func NewPropertiesRebinder(environment Environment, container container.Container) *PropertiesRebinder {
+	if environment == nil {
+		panic("nil environment")
+	}
+
+	if container == nil {
+		panic("nil container")
+	}
+
+	return &PropertiesRebinder{
+		environment: environment,
+		container:   container,
+	}
+}
+
+// OnEvent method rebinds the properties objects.
+func (r *PropertiesRebinder) OnEvent(ctx context.Context, event event.ApplicationEvent) error {
+	return r.Rebind(ctx)
+}
+
+// SupportsEvent method checks if the event is an EnvironmentChangedEvent.
+func (r *PropertiesRebinder) SupportsEvent(event event.ApplicationEvent) bool {
+	_, ok := event.(EnvironmentChangedEvent)
+	return ok
+}
+
+// Rebind method rebinds the properties of the environment to the singleton properties objects in the container.
+// It returns the joined errors of the objects which cannot be rebound, and the other objects are still rebound.
+func (r *PropertiesRebinder) Rebind(ctx context.Context) error {
+	var err error
+
+	for _, object := range r.container.Singletons().List(filter.ByTypeOf[property.Properties]()) {
+		err = errors.Join(err, r.rebind(object))
+	}
+
+	return err
+}
+
+// rebind method binds the properties to a new instance of the type of the given object
+// and replaces the bound fields of the object with the fields of the new instance while holding its lock.
+// The objects which do not implement sync.Locker are skipped.
+func (r *PropertiesRebinder) rebind(object any) error {
+	locker, ok := object.(sync.Locker)
+	if !ok {
+		return nil
+	}
+
+	value := reflect.ValueOf(object)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	rebound := reflect.New(value.Elem().Type())
+
+	binder := property.NewBinder(r.environment.PropertyResolver())
+	err := binder.Bind(rebound.Interface())
+	if err != nil {
+		return err
+	}
+
+	err = property.Validate(rebound.Interface())
+	if err != nil {
+		return err
+	}
+
+	defer locker.Unlock()
+	locker.Lock()
+
+	typ := value.Elem().Type()
+	for index := 0; index < typ.NumField(); index++ {
+		if isBoundField(typ.Field(index)) {
+			value.Elem().Field(index).Set(rebound.Elem().Field(index))
+		}
+	}
+
+	return nil
+}
+
+// lockerType is the type of the sync.Locker interface.
+var lockerType = reflect.TypeFor[sync.Locker]()
+
+// isBoundField function checks if the given field is bound by the binder, which skips the unexported fields,
+// the embedded property.Properties and the fields ignored by the '-' prop tag. The locks such as an embedded
+// sync.RWMutex are not considered as bound fields, so that they are not replaced while they are held.
+func isBoundField(field reflect.StructField) bool {
+	if !field.IsExported() || field.Tag.Get("prop") == "-" || reflect.PointerTo(field.Type).Implements(lockerType) {
+		return false
+	}
+
+	return !field.Anonymous || field.Type != reflect.TypeFor[property.Properties]()
+}
@@ -0,0 +1,215 @@
+package runtime
+
+import (
+	"codnect.io/procyon-core/component/container"
+	"codnect.io/procyon-core/runtime/property"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPropertiesProcessor_ProcessBeforeInitShouldBindPropertiesToPropertiesObject(t *testing.T) {
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(property.NewMapSource("anySource", map[string]any{
+		"procyon.server.port": 8080,
+	}))
+
+	processor := NewPropertiesProcessor(environment)
+	result, err := processor.ProcessBeforeInit(context.Background(), NewServerProperties())
+
+	assert.Nil(t, err)
+	assert.Equal(t, 8080, result.(*ServerProperties).Port)
+}
+
+func TestPropertiesProcessor_ProcessBeforeInitShouldSkipObjectsNotImplementingProperties(t *testing.T) {
+	processor := NewPropertiesProcessor(NewDefaultEnvironment())
+	object := &anyLifecycle{}
+	result, err := processor.ProcessBeforeInit(context.Background(), object)
+
+	assert.Nil(t, err)
+	assert.Equal(t, object, result)
+}
+
+type anyValidatedProperties struct {
+	property.Properties `prefix:"procyon.any"`
+	sync.RWMutex
+
+	Port int `prop:"port" validate:"min=1,max=65535"`
+}
+
+func TestPropertiesProcessor_ProcessBeforeInitShouldReturnErrorIfBoundPropertiesAreInvalid(t *testing.T) {
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port": 70000,
+	}))
+
+	processor := NewPropertiesProcessor(environment)
+	result, err := processor.ProcessBeforeInit(context.Background(), &anyValidatedProperties{})
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "invalid properties: procyon.any.port must be between 1 and 65535")
+}
+
+func TestPropertiesRebinder_OnEventShouldRebindSingletonPropertiesObjects(t *testing.T) {
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port": 8080,
+	}))
+
+	properties := &anyValidatedProperties{Port: 80}
+	c := container.New()
+	assert.Nil(t, c.Singletons().Register("anyProperties", properties))
+
+	rebinder := NewPropertiesRebinder(environment, c)
+	changedEvent := NewEnvironmentChangedEvent(environment, []string{"procyon.any.port"})
+	assert.True(t, rebinder.SupportsEvent(changedEvent))
+	assert.False(t, rebinder.SupportsEvent(NewStartupEvent(nil)))
+
+	err := rebinder.OnEvent(context.Background(), changedEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, 8080, properties.Port)
+
+	environment.PropertySources().Replace("anySource", property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port": 70000,
+	}))
+
+	err = rebinder.OnEvent(context.Background(), changedEvent)
+	assert.EqualError(t, err, "invalid properties: procyon.any.port must be between 1 and 65535")
+	assert.Equal(t, 8080, properties.Port)
+}
+
+type anyReloadableProperties struct {
+	property.Properties `prefix:"procyon.any"`
+	sync.RWMutex
+
+	Port    int               `prop:"port" default:"8080"`
+	Hosts   []string          `prop:"hosts"`
+	Labels  map[string]string `prop:"labels"`
+	Ignored string            `prop:"-"`
+}
+
+func TestPropertiesRebinder_RebindShouldRestoreDefaultValuesOfRemovedProperties(t *testing.T) {
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port":       9090,
+		"procyon.any.hosts":      []any{"anyHost"},
+		"procyon.any.labels.env": "prod",
+	}))
+
+	properties := &anyReloadableProperties{Ignored: "anyValue"}
+	c := container.New()
+	assert.Nil(t, c.Singletons().Register("anyProperties", properties))
+
+	rebinder := NewPropertiesRebinder(environment, c)
+	assert.Nil(t, rebinder.Rebind(context.Background()))
+	assert.Equal(t, 9090, properties.Port)
+	assert.Equal(t, []string{"anyHost"}, properties.Hosts)
+	assert.Equal(t, map[string]string{"env": "prod"}, properties.Labels)
+
+	environment.PropertySources().Replace("anySource", property.NewMapSource("anySource", map[string]any{}))
+
+	assert.Nil(t, rebinder.Rebind(context.Background()))
+	assert.Equal(t, 8080, properties.Port)
+	assert.Nil(t, properties.Hosts)
+	assert.Nil(t, properties.Labels)
+	assert.Equal(t, "anyValue", properties.Ignored)
+}
+
+func TestPropertiesRebinder_RebindShouldReplaceFieldsWhileHoldingLockOfObject(t *testing.T) {
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port": 9090,
+	}))
+
+	properties := &anyReloadableProperties{}
+	c := container.New()
+	assert.Nil(t, c.Singletons().Register("anyProperties", properties))
+
+	properties.RLock()
+	done := make(chan error, 1)
+	go func() {
+		done <- NewPropertiesRebinder(environment, c).Rebind(context.Background())
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("properties are rebound while a reader holds the lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, 0, properties.Port)
+	properties.RUnlock()
+
+	assert.Nil(t, <-done)
+
+	properties.RLock()
+	defer properties.RUnlock()
+	assert.Equal(t, 9090, properties.Port)
+}
+
+func TestPropertiesRebinder_RebindShouldNotRaceWithReadersHoldingLockOfObject(t *testing.T) {
+	source := property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port":  9090,
+		"procyon.any.hosts": []any{"anyHost"},
+	})
+
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(source)
+
+	properties := &anyReloadableProperties{}
+	c := container.New()
+	assert.Nil(t, c.Singletons().Register("anyProperties", properties))
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			properties.RLock()
+			_, _ = properties.Port, len(properties.Hosts)
+			properties.RUnlock()
+		}
+	}()
+
+	rebinder := NewPropertiesRebinder(environment, c)
+	for index := 0; index < 100; index++ {
+		assert.Nil(t, rebinder.Rebind(context.Background()))
+	}
+
+	close(stop)
+	<-done
+
+	properties.RLock()
+	defer properties.RUnlock()
+	assert.Equal(t, 9090, properties.Port)
+	assert.Equal(t, []string{"anyHost"}, properties.Hosts)
+}
+
+type anyUnlockedProperties struct {
+	property.Properties `prefix:"procyon.any"`
+
+	Port int `prop:"port" default:"8080"`
+}
+
+func TestPropertiesRebinder_RebindShouldSkipObjectsNotImplementingLocker(t *testing.T) {
+	environment := NewDefaultEnvironment()
+	environment.PropertySources().AddLast(property.NewMapSource("anySource", map[string]any{
+		"procyon.any.port": 9090,
+	}))
+
+	properties := &anyUnlockedProperties{Port: 8080}
+	c := container.New()
+	assert.Nil(t, c.Singletons().Register("anyProperties", properties))
+
+	assert.Nil(t, NewPropertiesRebinder(environment, c).Rebind(context.Background()))
+	assert.Equal(t, 8080, properties.Port)
+}
@@ -0,0 +1,216 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Profiles interface represents a profile predicate which is matched against the active profiles.
+type Profiles interface {
+	// Matches returns true if the profiles match the given function, which reports whether a profile is active.
+	Matches(isActive func(profile string) bool) bool
+}
+
+// profilesFunc type is a function implementing the Profiles interface.
+type profilesFunc func(isActive func(profile string) bool) bool
+
+// Matches method calls the function itself.
+func (f profilesFunc) Matches(isActive func(profile string) bool) bool {
+	return f(isActive)
+}
+
+// ParseProfiles function parses the given profile expressions, such as 'prod', '!dev' or 'prod & (us | eu)'.
+// The expressions support the '!' (not), '&' (and) and '|' (or) operators and the parentheses,
+// where '&' takes precedence over '|'. The returned profiles match if any of the expressions matches.
+func ParseProfiles(expressions ...string) (Profiles, error) {
+	if len(expressions) == 0 {
+		return nil, fmt.Errorf("no profile expression")
+	}
+
+	parsed := make([]Profiles, 0, len(expressions))
+
+	for _, expression := range expressions {
+		parser := &profilesParser{
+			expression: expression,
+			tokens:     tokenizeProfiles(expression),
+		}
+
+		profiles, err := parser.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, profiles)
+	}
+
+	return anyProfiles(parsed), nil
+}
+
+// anyProfiles function returns profiles matching if any of the given profiles matches.
+func anyProfiles(profiles []Profiles) Profiles {
+	return profilesFunc(func(isActive func(profile string) bool) bool {
+		for _, p := range profiles {
+			if p.Matches(isActive) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// allProfiles function returns profiles matching if all the given profiles match.
+func allProfiles(profiles []Profiles) Profiles {
+	return profilesFunc(func(isActive func(profile string) bool) bool {
+		for _, p := range profiles {
+			if !p.Matches(isActive) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// tokenizeProfiles function splits the given profile expression into the operators, the parentheses and the profile names.
+func tokenizeProfiles(expression string) []string {
+	tokens := make([]string, 0)
+	start := -1
+
+	for index, char := range expression {
+		switch {
+		case strings.ContainsRune("!&|()", char):
+			if start != -1 {
+				tokens = append(tokens, expression[start:index])
+				start = -1
+			}
+
+			tokens = append(tokens, string(char))
+		case char == ' ' || char == '\t':
+			if start != -1 {
+				tokens = append(tokens, expression[start:index])
+				start = -1
+			}
+		default:
+			if start == -1 {
+				start = index
+			}
+		}
+	}
+
+	if start != -1 {
+		tokens = append(tokens, expression[start:])
+	}
+
+	return tokens
+}
+
+// profilesParser struct parses a profile expression by recursive descent.
+type profilesParser struct {
+	expression string
+	tokens     []string
+	position   int
+}
+
+// parse method parses the whole expression.
+func (p *profilesParser) parse() (Profiles, error) {
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("invalid profile expression '%s': expression is empty", p.expression)
+	}
+
+	profiles, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.position != len(p.tokens) {
+		return nil, fmt.Errorf("invalid profile expression '%s': unexpected '%s'", p.expression, p.tokens[p.position])
+	}
+
+	return profiles, nil
+}
+
+// parseOr method parses the operands separated by '|'.
+func (p *profilesParser) parseOr() (Profiles, error) {
+	operands := make([]Profiles, 0)
+
+	for {
+		operand, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, operand)
+
+		if !p.consume("|") {
+			return anyProfiles(operands), nil
+		}
+	}
+}
+
+// parseAnd method parses the operands separated by '&'.
+func (p *profilesParser) parseAnd() (Profiles, error) {
+	operands := make([]Profiles, 0)
+
+	for {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		operands = append(operands, operand)
+
+		if !p.consume("&") {
+			return allProfiles(operands), nil
+		}
+	}
+}
+
+// parseUnary method parses a negated operand, a parenthesized expression or a profile name.
+func (p *profilesParser) parseUnary() (Profiles, error) {
+	if p.position == len(p.tokens) {
+		return nil, fmt.Errorf("invalid profile expression '%s': unexpected end of expression", p.expression)
+	}
+
+	token := p.tokens[p.position]
+	p.position++
+
+	switch token {
+	case "!":
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return profilesFunc(func(isActive func(profile string) bool) bool {
+			return !operand.Matches(isActive)
+		}), nil
+	case "(":
+		operand, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.consume(")") {
+			return nil, fmt.Errorf("invalid profile expression '%s': missing ')'", p.expression)
+		}
+
+		return operand, nil
+	case "&", "|", ")":
+		return nil, fmt.Errorf("invalid profile expression '%s': unexpected '%s'", p.expression, token)
+	}
+
+	return profilesFunc(func(isActive func(profile string) bool) bool {
+		return isActive(token)
+	}), nil
+}
+
+// consume method skips the next token if it is the given token.
+func (p *profilesParser) consume(token string) bool {
+	if p.position < len(p.tokens) && p.tokens[p.position] == token {
+		p.position++
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func TestParseProfiles_ShouldMatchExpressionsAgainstActiveProfiles(t *testing.T) {
+	testCases := []struct {
+		expressions    []string
+		activeProfiles []string
+		matches        bool
+	}{
+		{[]string{"prod"}, []string{"prod"}, true},
+		{[]string{"prod"}, []string{"dev"}, false},
+		{[]string{"!prod"}, []string{"dev"}, true},
+		{[]string{"prod & !eu"}, []string{"prod"}, true},
+		{[]string{"prod & !eu"}, []string{"prod", "eu"}, false},
+		{[]string{"prod | dev"}, []string{"dev"}, true},
+		{[]string{"prod & (us | eu)"}, []string{"prod", "eu"}, true},
+		{[]string{"prod & (us | eu)"}, []string{"prod"}, false},
+		{[]string{"!(prod|dev)"}, []string{"test"}, true},
+		{[]string{"dev | prod & eu"}, []string{"dev"}, true},
+		{[]string{"prod", "dev"}, []string{"dev"}, true},
+	}
+
+	for _, testCase := range testCases {
+		profiles, err := ParseProfiles(testCase.expressions...)
+		assert.Nil(t, err)
+
+		matches := profiles.Matches(func(profile string) bool {
+			return slices.Contains(testCase.activeProfiles, profile)
+		})
+		assert.Equal(t, testCase.matches, matches, "%v with %v", testCase.expressions, testCase.activeProfiles)
+	}
+}
+
+func TestParseProfiles_ShouldReturnErrorIfExpressionIsInvalid(t *testing.T) {
+	_, err := ParseProfiles("prod &")
+	assert.EqualError(t, err, "invalid profile expression 'prod &': unexpected end of expression")
+
+	_, err = ParseProfiles("(prod | dev")
+	assert.EqualError(t, err, "invalid profile expression '(prod | dev': missing ')'")
+
+	_, err = ParseProfiles("prod dev")
+	assert.EqualError(t, err, "invalid profile expression 'prod dev': unexpected 'dev'")
+
+	_, err = ParseProfiles(" ")
+	assert.EqualError(t, err, "invalid profile expression ' ': expression is empty")
+}
@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"codnect.io/procyon-core/runtime/property"
+	"fmt"
 	"os"
 	"strings"
 )
@@ -8,6 +10,8 @@ import (
 const (
 	// NonOptionArgs represents the non-option arguments.
 	NonOptionArgs = "nonOptionArgs"
+	// EnvironmentSourceName is the name of the EnvironmentSource.
+	EnvironmentSourceName = "systemEnvironment"
 )
 
 // ArgumentsSource struct represents a source of arguments.
@@ -73,6 +77,20 @@ func (s *ArgumentsSource) PropertyOrDefault(name string, defaultValue any) any {
 	return val
 }
 
+// PropertyOrigin method returns the origin of the argument with the given name,
+// which is located at the index of its first occurrence in the arguments.
+func (s *ArgumentsSource) PropertyOrigin(name string) (property.Origin, bool) {
+	index, ok := s.args.optionIndex(name)
+	if !ok {
+		return property.Origin{}, false
+	}
+
+	return property.Origin{
+		Source:   s.Name(),
+		Location: fmt.Sprintf("argument at index %d", index),
+	}, true
+}
+
 // PropertyNames method returns the names of the arguments.
 func (s *ArgumentsSource) PropertyNames() []string {
 	return s.args.OptionNames()
@@ -114,7 +132,7 @@ func NewEnvironmentSource() *EnvironmentSource {
 
 // Name method returns the name of the source.
 func (s *EnvironmentSource) Name() string {
-	return "systemEnvironment"
+	return EnvironmentSourceName
 }
 
 // Source method returns the source of the environment properties.
@@ -129,23 +147,28 @@ func (s *EnvironmentSource) Source() any {
 
 // Property method returns the value of the environment property with the given name.
 func (s *EnvironmentSource) Property(name string) (any, bool) {
-	propertyName, exists := s.checkPropertyName(strings.ToLower(name))
+	variableName, exists := s.variableName(name)
 
 	if exists {
-		if value, ok := s.variables[propertyName]; ok {
-			return value, true
-		}
+		return s.variables[variableName], true
 	}
 
-	propertyName, exists = s.checkPropertyName(strings.ToUpper(name))
+	return nil, false
+}
 
-	if exists {
-		if value, ok := s.variables[propertyName]; ok {
-			return value, true
-		}
+// PropertyOrigin method returns the origin of the environment property with the given name,
+// which is the environment variable the property is resolved from.
+func (s *EnvironmentSource) PropertyOrigin(name string) (property.Origin, bool) {
+	variableName, exists := s.variableName(name)
+
+	if !exists {
+		return property.Origin{}, false
 	}
 
-	return nil, false
+	return property.Origin{
+		Source:   s.Name(),
+		Location: fmt.Sprintf("environment variable '%s'", variableName),
+	}, true
 }
 
 // PropertyOrDefault returns the value of the given environment property name from the source.
@@ -186,6 +209,32 @@ func (s *EnvironmentSource) PropertyNames() []string {
 	return keys
 }
 
+// ContainsPrefix method checks whether there is any environment property under the given prefix.
+// The prefix is matched in the same relaxed way as the property names, so that the 'procyon.server'
+// prefix matches the 'PROCYON_SERVER_PORT' variable.
+func (s *EnvironmentSource) ContainsPrefix(prefix string) bool {
+	relaxedPrefix := relaxedVariableName(prefix) + "_"
+
+	for key := range s.variables {
+		if strings.HasPrefix(relaxedVariableName(key), relaxedPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// variableName method returns the name of the environment variable which the given property name is resolved from.
+func (s *EnvironmentSource) variableName(name string) (string, bool) {
+	variableName, exists := s.checkPropertyName(strings.ToLower(name))
+
+	if exists {
+		return variableName, true
+	}
+
+	return s.checkPropertyName(strings.ToUpper(name))
+}
+
 // checkPropertyName method checks the given property name in the environment variables.
 func (s *EnvironmentSource) checkPropertyName(name string) (string, bool) {
 	if s.contains(name) {
@@ -221,3 +270,70 @@ func (s *EnvironmentSource) contains(name string) bool {
 
 	return false
 }
+
+// relaxedVariableName function returns the relaxed form of the given name, in which the dots and hyphens
+// are replaced with underscores and the letters are upper case.
+func relaxedVariableName(name string) string {
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(name))
+}
+
+const (
+	// ApplicationJsonSourceName is the name of the source created from the inline application JSON.
+	ApplicationJsonSourceName = "procyonApplicationJson"
+	// ApplicationJsonProperty is the name of the argument which holds the inline application JSON.
+	ApplicationJsonProperty = "procyon.application.json"
+	// ApplicationJsonVariable is the name of the environment variable which holds the inline application JSON.
+	ApplicationJsonVariable = "PROCYON_APPLICATION_JSON"
+)
+
+// NewApplicationJsonSource function creates a new source from the inline application JSON, which is read
+// from the '--procyon.application.json' argument or the 'PROCYON_APPLICATION_JSON' environment variable.
+// The argument takes precedence over the environment variable. If neither of them exists, it returns nil.
+func NewApplicationJsonSource(args *Arguments) (property.Source, error) {
+	var document string
+
+	var location string
+
+	if args != nil && len(args.OptionValues(ApplicationJsonProperty)) != 0 {
+		values := args.OptionValues(ApplicationJsonProperty)
+		document = values[len(values)-1]
+		location = fmt.Sprintf("argument '--%s'", ApplicationJsonProperty)
+	} else if value, ok := os.LookupEnv(ApplicationJsonVariable); ok {
+		document = value
+		location = fmt.Sprintf("environment variable '%s'", ApplicationJsonVariable)
+	}
+
+	if strings.TrimSpace(document) == "" {
+		return nil, nil
+	}
+
+	source, err := property.NewJsonSourceLoader().Load(ApplicationJsonSourceName, strings.NewReader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	return &applicationJsonSource{
+		MapSource: source.(*property.MapSource),
+		location:  location,
+	}, nil
+}
+
+// applicationJsonSource struct represents the source created from the inline application JSON.
+// The origins of its properties are reported by the argument or the environment variable holding the JSON.
+type applicationJsonSource struct {
+	*property.MapSource
+	location string
+}
+
+// PropertyOrigin method returns the origin of the property with the given name, which is the argument
+// or the environment variable holding the inline application JSON.
+func (s *applicationJsonSource) PropertyOrigin(name string) (property.Origin, bool) {
+	if !s.ContainsProperty(name) {
+		return property.Origin{}, false
+	}
+
+	return property.Origin{
+		Source:   s.Name(),
+		Location: s.location,
+	}, true
+}
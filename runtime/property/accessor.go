@@ -0,0 +1,94 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Get function returns the value of the property with the given name converted to the type T
+// by the conversion service of the given resolver. If the type T is a slice and the property does not exist,
+// the items are read from the indexed properties such as 'name.0' and 'name.1'.
+// It returns an error wrapping ErrPropertyNotFound if the property does not exist,
+// or an error if a placeholder in the value cannot be resolved.
+func Get[T any](resolver Resolver, name string) (T, error) {
+	var zero T
+	if resolver == nil {
+		return zero, fmt.Errorf("nil resolver")
+	}
+
+	value, err := lookup(resolver, name, reflect.TypeFor[T]())
+	if err != nil {
+		return zero, err
+	}
+
+	converted, err := ConversionServiceOf(resolver).Convert(value, reflect.TypeFor[T]())
+	if err != nil {
+		return zero, fmt.Errorf("failed to convert property '%s' with value '%v' to %s: %w", name, value, reflect.TypeFor[T](), err)
+	}
+
+	if converted == nil {
+		return zero, nil
+	}
+
+	return converted.(T), nil
+}
+
+// GetOrDefault function returns the value of the property with the given name converted to the type T.
+// If the property does not exist, it returns the given default value.
+// It returns an error if the property exists but cannot be converted.
+func GetOrDefault[T any](resolver Resolver, name string, defaultValue T) (T, error) {
+	value, err := Get[T](resolver, name)
+	if errors.Is(err, ErrPropertyNotFound) {
+		return defaultValue, nil
+	}
+
+	return value, err
+}
+
+// MustGet function returns the value of the property with the given name converted to the type T.
+// It panics if the property does not exist or cannot be converted.
+func MustGet[T any](resolver Resolver, name string) T {
+	value, err := Get[T](resolver, name)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// lookup function returns the value of the property with the given name with its placeholders resolved.
+// If the given type is a slice and the property does not exist, the values of the indexed properties are returned.
+// If the resolver implements the ListLookup interface, the indexed properties are read only from the source
+// with the highest precedence which contains the list.
+func lookup(resolver Resolver, name string, typ reflect.Type) (any, error) {
+	value, err := requiredProperty(resolver, name)
+	if !errors.Is(err, ErrPropertyNotFound) || typ.Kind() != reflect.Slice {
+		return value, err
+	}
+
+	size := -1
+	if listLookup, ok := resolver.(ListLookup); ok {
+		size = listLookup.ListSize(name)
+	}
+
+	items := make([]any, 0)
+	for index := 0; size < 0 || index < size; index++ {
+		item, itemErr := requiredProperty(resolver, indexedName(name, index))
+		if errors.Is(itemErr, ErrPropertyNotFound) {
+			break
+		}
+
+		if itemErr != nil {
+			return nil, itemErr
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return nil, err
+	}
+
+	return items, nil
+}
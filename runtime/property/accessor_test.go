@@ -0,0 +1,121 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newAnyResolver() Resolver {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon": map[string]any{
+			"server": map[string]any{
+				"port":    "8080",
+				"timeout": "5s",
+				"hosts":   []any{"localhost", "codnect.io"},
+				"ports":   []any{8080, "8081"},
+				"names":   "any,another",
+			},
+		},
+	}))
+
+	return NewSourcesResolver(sources)
+}
+
+func TestGet_ShouldReturnPropertyValueConvertedToGivenType(t *testing.T) {
+	resolver := newAnyResolver()
+
+	port, err := Get[int](resolver, "procyon.server.port")
+	assert.Nil(t, err)
+	assert.Equal(t, 8080, port)
+
+	timeout, err := Get[time.Duration](resolver, "procyon.server.timeout")
+	assert.Nil(t, err)
+	assert.Equal(t, 5*time.Second, timeout)
+}
+
+func TestGet_ShouldReturnListValuesFromIndexedProperties(t *testing.T) {
+	resolver := newAnyResolver()
+
+	hosts, err := Get[[]string](resolver, "procyon.server.hosts")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"localhost", "codnect.io"}, hosts)
+
+	ports, err := Get[[]int](resolver, "procyon.server.ports")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{8080, 8081}, ports)
+
+	names, err := Get[[]string](resolver, "procyon.server.names")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"any", "another"}, names)
+}
+
+func TestGet_ShouldReturnListValuesOnlyFromSourceWithHighestPrecedence(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("prodSource", map[string]any{
+		"procyon.server.hosts": []any{"x"},
+	}))
+	sources.AddLast(NewMapSource("defaultSource", map[string]any{
+		"procyon.server.hosts": []any{"a", "b", "c"},
+	}))
+
+	hosts, err := Get[[]string](NewSourcesResolver(sources), "procyon.server.hosts")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"x"}, hosts)
+}
+
+// anyPlainResolver struct implements only the Resolver interface.
+type anyPlainResolver struct {
+	Resolver
+}
+
+func TestGet_ShouldReturnPropertyValueFromResolverWhichIsNotConfigurable(t *testing.T) {
+	resolver := &anyPlainResolver{Resolver: newAnyResolver()}
+
+	port, err := Get[int](resolver, "procyon.server.port")
+	assert.Nil(t, err)
+	assert.Equal(t, 8080, port)
+
+	hosts, err := Get[[]string](resolver, "procyon.server.hosts")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"localhost", "codnect.io"}, hosts)
+
+	_, err = Get[int](resolver, "procyon.server.missing")
+	assert.ErrorIs(t, err, ErrPropertyNotFound)
+}
+
+func TestGet_ShouldReturnErrorIfPropertyDoesNotExist(t *testing.T) {
+	_, err := Get[int](newAnyResolver(), "procyon.server.missing")
+
+	assert.ErrorIs(t, err, ErrPropertyNotFound)
+	assert.EqualError(t, err, "property not found: 'procyon.server.missing'")
+}
+
+func TestGet_ShouldReturnErrorIfPropertyCannotBeConverted(t *testing.T) {
+	_, err := Get[bool](newAnyResolver(), "procyon.server.timeout")
+
+	assert.EqualError(t, err, "failed to convert property 'procyon.server.timeout' with value '5s' to bool: "+
+		"strconv.ParseBool: parsing \"5s\": invalid syntax")
+}
+
+func TestGetOrDefault_ShouldReturnDefaultValueIfPropertyDoesNotExist(t *testing.T) {
+	resolver := newAnyResolver()
+
+	port, err := GetOrDefault(resolver, "procyon.server.missing", 9090)
+	assert.Nil(t, err)
+	assert.Equal(t, 9090, port)
+
+	port, err = GetOrDefault(resolver, "procyon.server.port", 9090)
+	assert.Nil(t, err)
+	assert.Equal(t, 8080, port)
+}
+
+func TestMustGet_ShouldPanicIfPropertyDoesNotExist(t *testing.T) {
+	resolver := newAnyResolver()
+
+	assert.Equal(t, 8080, MustGet[int](resolver, "procyon.server.port"))
+	assert.Panics(t, func() {
+		MustGet[int](resolver, "procyon.server.missing")
+	})
+}
@@ -0,0 +1,414 @@
+package property
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// defaultSourceName is the source name reported for the values supplied by default tags.
+	defaultSourceName = "default"
+)
+
+// BindError struct represents an error that occurs when a property value cannot be bound to a field.
+type BindError struct {
+	Name   string // The full name of the property.
+	Source string // The name of the source which supplied the value.
+	Origin Origin // The origin of the value in the source.
+	Value  any    // The value which cannot be bound.
+	Err    error  // The underlying error.
+}
+
+// Error method returns the error message.
+// The origin of the value is cited if it is known, otherwise the name of the source is cited.
+func (e *BindError) Error() string {
+	origin := e.Origin
+	if origin.Source == "" {
+		origin = Origin{Source: e.Source}
+	}
+
+	return fmt.Sprintf("failed to bind property '%s' with value '%v' from %s: %s", e.Name, e.Value, origin, e.Err)
+}
+
+// Unwrap method returns the underlying error.
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+// Binder struct binds the properties resolved by a Resolver to struct fields.
+// The prefix of a struct is read from the prefix tag of its embedded Properties field,
+// the name of a field is read from its prop tag and its default value is read from its default tag.
+// The fields without prop tags are bound by the kebab-case forms of their names.
+type Binder struct {
+	resolver Resolver
+}
+
+// NewBinder function creates a new Binder with the given resolver.
+func NewBinder(resolver Resolver) *Binder {
+	if resolver == nil {
+		panic("nil resolver")
+	}
+
+	return &Binder{
+		resolver: resolver,
+	}
+}
+
+// Bind method binds the properties to the given struct pointer.
+// It returns an error if the target is not a struct pointer or any property cannot be bound.
+func (b *Binder) Bind(target any) error {
+	if target == nil {
+		return errors.New("nil target")
+	}
+
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("target must be a non-nil struct pointer, but got %T", target)
+	}
+
+	return b.bindStruct(Prefix(value.Elem().Type()), value.Elem())
+}
+
+// Prefix function returns the prefix of the given property struct type.
+// The prefix is read from the prefix tag of the embedded Properties field.
+func Prefix(typ reflect.Type) string {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for index := 0; index < typ.NumField(); index++ {
+		field := typ.Field(index)
+
+		if field.Anonymous && field.Type == propertiesType {
+			return field.Tag.Get("prefix")
+		}
+	}
+
+	return ""
+}
+
+// propertiesType is the type of the Properties interface.
+var propertiesType = reflect.TypeFor[Properties]()
+
+// textUnmarshalerType is the type of the encoding.TextUnmarshaler interface.
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// bindStruct method binds the properties under the given path to the fields of the given struct value.
+func (b *Binder) bindStruct(path string, value reflect.Value) error {
+	var err error
+	typ := value.Type()
+
+	for index := 0; index < typ.NumField(); index++ {
+		field := typ.Field(index)
+
+		if !field.IsExported() || (field.Anonymous && field.Type == propertiesType) {
+			continue
+		}
+
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		defaultValue, hasDefault := field.Tag.Lookup("default")
+		err = errors.Join(err, b.bindValue(joinPath(path, name), value.Field(index), defaultValue, hasDefault))
+	}
+
+	return err
+}
+
+// bindValue method binds the property with the given name to the given value.
+// If the property does not exist, the default value is used if there is any. The types which have
+// a registered converter, such as url.URL, are bound as scalars instead of being bound field by field.
+func (b *Binder) bindValue(name string, value reflect.Value, defaultValue string, hasDefault bool) error {
+	typ := value.Type()
+
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) || ConversionServiceOf(b.resolver).HasConverter(typ) {
+		return b.bindScalar(name, value, defaultValue, hasDefault)
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		if !b.containsProperties(name) && !hasDefault {
+			return nil
+		}
+
+		if value.IsNil() {
+			value.Set(reflect.New(typ.Elem()))
+		}
+
+		return b.bindValue(name, value.Elem(), defaultValue, hasDefault)
+	case reflect.Struct:
+		return b.bindStruct(name, value)
+	case reflect.Slice:
+		return b.bindSlice(name, value, defaultValue, hasDefault)
+	case reflect.Map:
+		return b.bindMap(name, value)
+	default:
+		return b.bindScalar(name, value, defaultValue, hasDefault)
+	}
+}
+
+// bindScalar method binds the property with the given name to the given scalar value.
+func (b *Binder) bindScalar(name string, value reflect.Value, defaultValue string, hasDefault bool) error {
+	propertyValue, origin, ok, err := b.property(name)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if !hasDefault {
+			return nil
+		}
+
+		propertyValue = defaultValue
+		origin = Origin{Source: defaultSourceName}
+	}
+
+	converted, err := b.convert(propertyValue, value.Type())
+	if err != nil {
+		return &BindError{
+			Name:   name,
+			Source: origin.Source,
+			Origin: origin,
+			Value:  propertyValue,
+			Err:    err,
+		}
+	}
+
+	value.Set(converted)
+	return nil
+}
+
+// bindSlice method binds the property with the given name to the given slice value.
+// The items are read from the indexed properties such as 'name.0' and 'name.1', or from
+// the comma-separated value of the property. If the resolver implements the ListLookup interface,
+// the items are read only from the source with the highest precedence which contains the list.
+func (b *Binder) bindSlice(name string, value reflect.Value, defaultValue string, hasDefault bool) error {
+	var err error
+	items := reflect.MakeSlice(value.Type(), 0, 0)
+
+	for index := 0; b.containsItem(name, index); index++ {
+		item := reflect.New(value.Type().Elem()).Elem()
+		err = errors.Join(err, b.bindValue(indexedName(name, index), item, "", false))
+		items = reflect.Append(items, item)
+	}
+
+	if items.Len() != 0 {
+		value.Set(items)
+		return err
+	}
+
+	propertyValue, origin, ok, err := b.property(name)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if !hasDefault {
+			return nil
+		}
+
+		propertyValue = defaultValue
+		origin = Origin{Source: defaultSourceName}
+	}
+
+	converted, err := b.convert(propertyValue, value.Type())
+	if err != nil {
+		return &BindError{
+			Name:   name,
+			Source: origin.Source,
+			Origin: origin,
+			Value:  propertyValue,
+			Err:    err,
+		}
+	}
+
+	value.Set(converted)
+	return nil
+}
+
+// bindMap method binds the properties under the given name to the given map value.
+// Only the maps with string keys are supported. For struct values, the first segment
+// after the name is used as the key, otherwise the rest of the property name is used.
+func (b *Binder) bindMap(name string, value reflect.Value) error {
+	typ := value.Type()
+	if typ.Key().Kind() != reflect.String {
+		return &BindError{
+			Name:   name,
+			Source: defaultSourceName,
+			Origin: Origin{Source: defaultSourceName},
+			Err:    fmt.Errorf("map key type must be string, but got %s", typ.Key()),
+		}
+	}
+
+	keys := make([]string, 0)
+	for _, propertyName := range b.propertyNames() {
+		if !strings.HasPrefix(propertyName, name+".") {
+			continue
+		}
+
+		key := strings.TrimPrefix(propertyName, name+".")
+		if typ.Elem().Kind() == reflect.Struct || typ.Elem().Kind() == reflect.Pointer {
+			key, _, _ = strings.Cut(key, ".")
+		}
+
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if value.IsNil() {
+		value.Set(reflect.MakeMap(typ))
+	}
+
+	var err error
+	for _, key := range keys {
+		item := reflect.New(typ.Elem()).Elem()
+		err = errors.Join(err, b.bindValue(joinPath(name, key), item, "", false))
+		value.SetMapIndex(reflect.ValueOf(key).Convert(typ.Key()), item)
+	}
+
+	return err
+}
+
+// convert method converts the given property value to the given type by the conversion service of the resolver.
+func (b *Binder) convert(value any, typ reflect.Type) (reflect.Value, error) {
+	converted, err := ConversionServiceOf(b.resolver).Convert(value, typ)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if converted == nil {
+		return reflect.Zero(typ), nil
+	}
+
+	return reflect.ValueOf(converted), nil
+}
+
+// property method returns the value of the property with the given name and its origin.
+// The placeholders in the value are resolved, and an error is returned if they cannot be resolved.
+func (b *Binder) property(name string) (any, Origin, bool, error) {
+	value, err := requiredProperty(b.resolver, name)
+	if errors.Is(err, ErrPropertyNotFound) {
+		return nil, Origin{}, false, nil
+	}
+
+	if err != nil {
+		return nil, Origin{}, false, err
+	}
+
+	origin, _ := propertyOrigin(b.resolver, name)
+	return value, origin, true, nil
+}
+
+// containsItem method checks if the list with the given name has an item at the given index.
+func (b *Binder) containsItem(name string, index int) bool {
+	if lookup, ok := b.resolver.(ListLookup); ok {
+		return index < lookup.ListSize(name)
+	}
+
+	return b.containsProperties(indexedName(name, index))
+}
+
+// containsProperties method checks if there is a property with the given name or under the given name.
+func (b *Binder) containsProperties(name string) bool {
+	if b.resolver.ContainsProperty(name) {
+		return true
+	}
+
+	if _, ok := b.resolver.Property(name); ok {
+		return true
+	}
+
+	if lookup, ok := b.resolver.(PrefixLookup); ok {
+		return lookup.ContainsPrefix(name)
+	}
+
+	for _, propertyName := range b.propertyNames() {
+		if strings.HasPrefix(propertyName, name+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// propertyNames method returns the property names which can be enumerated by the resolver.
+func (b *Binder) propertyNames() []string {
+	if enumerator, ok := b.resolver.(propertyEnumerator); ok {
+		return enumerator.propertyNames()
+	}
+
+	return nil
+}
+
+// propertyEnumerator interface is implemented by the resolvers which are able to enumerate the property names.
+type propertyEnumerator interface {
+	propertyNames() []string
+}
+
+// fieldName function returns the property name of the given field.
+// It returns false if the field is ignored by the '-' prop tag.
+func fieldName(field reflect.StructField) (string, bool) {
+	name, ok := field.Tag.Lookup("prop")
+
+	if name == "-" {
+		return "", false
+	}
+
+	if !ok || strings.TrimSpace(name) == "" {
+		return kebabCase(field.Name), true
+	}
+
+	return name, true
+}
+
+// kebabCase function converts the given name to kebab-case, such as 'MaxIdle' to 'max-idle'.
+func kebabCase(name string) string {
+	var builder strings.Builder
+	runes := []rune(name)
+
+	for index, r := range runes {
+		if unicode.IsUpper(r) {
+			if index > 0 && (unicode.IsLower(runes[index-1]) || (index+1 < len(runes) && unicode.IsLower(runes[index+1]))) {
+				builder.WriteRune('-')
+			}
+
+			builder.WriteRune(unicode.ToLower(r))
+			continue
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+// joinPath function joins the given path and name with a dot.
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// indexedName function returns the name of the item at the given index, such as 'name.0'.
+func indexedName(name string, index int) string {
+	return name + "." + strconv.Itoa(index)
+}
@@ -0,0 +1,238 @@
+package property
+
+import (
+	"codnect.io/procyon-core/runtime/conversion"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type anyPoolProperties struct {
+	MaxIdle int           `prop:"max-idle" default:"8"`
+	Timeout time.Duration `prop:"timeout"`
+}
+
+type anyProperties struct {
+	Properties `prefix:"procyon.datasource"`
+
+	URL      string                       `prop:"url"`
+	Port     int                          `prop:"port"`
+	Enabled  bool                         `prop:"enabled" default:"true"`
+	Timeout  time.Duration                `prop:"timeout" default:"30000"`
+	Hosts    []string                     `prop:"hosts"`
+	Ports    []int                        `prop:"ports"`
+	Labels   map[string]string            `prop:"labels"`
+	Pool     anyPoolProperties            `prop:"pool"`
+	Replica  *anyPoolProperties           `prop:"replica"`
+	Pools    map[string]anyPoolProperties `prop:"pools"`
+	Address  netip.Addr                   `prop:"address"`
+	Ignored  string                       `prop:"-"`
+	MaxConns int
+}
+
+func TestBinder_BindShouldBindPropertiesToStructFields(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon": map[string]any{
+			"datasource": map[string]any{
+				"url":       "jdbc://localhost",
+				"port":      5432,
+				"timeout":   "5s",
+				"hosts":     []any{"host1", "host2"},
+				"ports":     "1, 2",
+				"labels":    map[string]any{"env": "prod", "zone": "eu"},
+				"pool":      map[string]any{"timeout": 100},
+				"pools":     map[string]any{"read": map[string]any{"max-idle": 4}},
+				"address":   "127.0.0.1",
+				"max-conns": 10,
+				"ignored":   "anyValue",
+			},
+		},
+	}))
+
+	properties := &anyProperties{}
+	binder := NewBinder(NewSourcesResolver(sources))
+	err := binder.Bind(properties)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "jdbc://localhost", properties.URL)
+	assert.Equal(t, 5432, properties.Port)
+	assert.True(t, properties.Enabled)
+	assert.Equal(t, 5*time.Second, properties.Timeout)
+	assert.Equal(t, []string{"host1", "host2"}, properties.Hosts)
+	assert.Equal(t, []int{1, 2}, properties.Ports)
+	assert.Equal(t, map[string]string{"env": "prod", "zone": "eu"}, properties.Labels)
+	assert.Equal(t, anyPoolProperties{MaxIdle: 8, Timeout: 100 * time.Millisecond}, properties.Pool)
+	assert.Nil(t, properties.Replica)
+	assert.Equal(t, map[string]anyPoolProperties{"read": {MaxIdle: 4}}, properties.Pools)
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), properties.Address)
+	assert.Equal(t, "", properties.Ignored)
+	assert.Equal(t, 10, properties.MaxConns)
+}
+
+func TestBinder_BindShouldUseDefaultValuesIfPropertiesDoNotExist(t *testing.T) {
+	properties := &anyProperties{}
+	binder := NewBinder(NewSourcesResolver(NewSources()))
+	err := binder.Bind(properties)
+
+	assert.Nil(t, err)
+	assert.True(t, properties.Enabled)
+	assert.Equal(t, 30*time.Second, properties.Timeout)
+	assert.Equal(t, 8, properties.Pool.MaxIdle)
+}
+
+func TestBinder_BindShouldReturnErrorWithPropertyNameAndSourceIfValueCannotBeConverted(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon.datasource.port": "anyPort",
+	}))
+
+	binder := NewBinder(NewSourcesResolver(sources))
+	err := binder.Bind(&anyProperties{})
+
+	assert.EqualError(t, err, "failed to bind property 'procyon.datasource.port' with value 'anyPort' "+
+		"from source 'anySource': strconv.ParseInt: parsing \"anyPort\": invalid syntax")
+}
+
+func TestBinder_BindShouldReturnErrorIfTargetIsNotStructPointer(t *testing.T) {
+	binder := NewBinder(NewSourcesResolver(NewSources()))
+	err := binder.Bind(anyProperties{})
+
+	assert.EqualError(t, err, "target must be a non-nil struct pointer, but got property.anyProperties")
+}
+
+func TestBinder_BindShouldResolvePlaceholdersInPropertyValues(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"db.host":                 "localhost",
+		"procyon.datasource.url":  "jdbc://${db.host}:${db.port:5432}",
+		"procyon.datasource.port": "${db.port:5432}",
+	}))
+
+	properties := &anyProperties{}
+	err := NewBinder(NewSourcesResolver(sources)).Bind(properties)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "jdbc://localhost:5432", properties.URL)
+	assert.Equal(t, 5432, properties.Port)
+}
+
+func TestBinder_BindShouldReturnErrorIfPlaceholderCannotBeResolved(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon.datasource.url": "jdbc://${db.host}",
+	}))
+
+	err := NewBinder(NewSourcesResolver(sources)).Bind(&anyProperties{})
+	assert.EqualError(t, err, "could not resolve placeholder '${db.host}' in property 'procyon.datasource.url' from source 'anySource'")
+}
+
+func TestBinder_BindShouldReturnErrorWithOriginIfValueCannotBeConverted(t *testing.T) {
+	source, err := NewYamlSourceLoader().Load("application.yml", strings.NewReader("procyon:\n  datasource:\n    port: anyPort\n"))
+	assert.Nil(t, err)
+
+	sources := NewSources()
+	sources.AddLast(source)
+
+	err = NewBinder(NewSourcesResolver(sources)).Bind(&anyProperties{})
+
+	bindErr := &BindError{}
+	assert.ErrorAs(t, err, &bindErr)
+	assert.Equal(t, Origin{Source: "application.yml", Location: "application.yml", Line: 3, Column: 11}, bindErr.Origin)
+	assert.EqualError(t, err, "failed to bind property 'procyon.datasource.port' with value 'anyPort' "+
+		"from source 'application.yml' (application.yml:3:11): strconv.ParseInt: parsing \"anyPort\": invalid syntax")
+}
+
+type anyCoordinate struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type anyEndpointProperties struct {
+	Properties `prefix:"app"`
+
+	Endpoint url.URL        `prop:"endpoint"`
+	Callback *url.URL       `prop:"callback"`
+	Location anyCoordinate  `prop:"location"`
+	Fallback *anyCoordinate `prop:"fallback"`
+	Missing  *url.URL       `prop:"missing"`
+}
+
+func TestBinder_BindShouldBindStructTypesWhichHaveRegisteredConverters(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"app.endpoint": "https://x.io/a",
+		"app.callback": "https://x.io/callback",
+		"app.location": "41.0,29.0",
+		"app.fallback": "40.0,28.0",
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	resolver.ConversionService().RegisterConverter(conversion.NewConverter(func(value any) (anyCoordinate, error) {
+		coordinate := anyCoordinate{}
+		_, err := fmt.Sscanf(fmt.Sprint(value), "%f,%f", &coordinate.Latitude, &coordinate.Longitude)
+		return coordinate, err
+	}))
+
+	properties := &anyEndpointProperties{}
+	err := NewBinder(resolver).Bind(properties)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "https://x.io/a", properties.Endpoint.String())
+	assert.Equal(t, "https://x.io/callback", properties.Callback.String())
+	assert.Equal(t, anyCoordinate{Latitude: 41, Longitude: 29}, properties.Location)
+	assert.Equal(t, &anyCoordinate{Latitude: 40, Longitude: 28}, properties.Fallback)
+	assert.Nil(t, properties.Missing)
+}
+
+type anyPrefixLookupSource struct {
+	*MapSource
+	prefixes []string
+}
+
+func (s *anyPrefixLookupSource) PropertyNames() []string {
+	return []string{"PROCYON_DATASOURCE_REPLICA_MAX_IDLE"}
+}
+
+func (s *anyPrefixLookupSource) ContainsPrefix(prefix string) bool {
+	return slices.Contains(s.prefixes, prefix)
+}
+
+func TestBinder_BindShouldBindPointerFieldIfSourceContainsPropertiesUnderItsName(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(&anyPrefixLookupSource{
+		MapSource: NewMapSource("anySource", map[string]any{
+			"procyon.datasource.replica.max-idle": 4,
+		}),
+		prefixes: []string{"procyon.datasource.replica"},
+	})
+
+	properties := &anyProperties{}
+	err := NewBinder(NewSourcesResolver(sources)).Bind(properties)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &anyPoolProperties{MaxIdle: 4}, properties.Replica)
+}
+
+func TestBinder_BindShouldBindSliceOnlyFromSourceWithHighestPrecedence(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("prodSource", map[string]any{
+		"procyon.datasource.hosts": []any{"x"},
+	}))
+	sources.AddLast(NewMapSource("defaultSource", map[string]any{
+		"procyon.datasource.hosts": []any{"a", "b", "c"},
+		"procyon.datasource.ports": []any{1, 2},
+	}))
+
+	properties := &anyProperties{}
+	err := NewBinder(NewSourcesResolver(sources)).Bind(properties)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"x"}, properties.Hosts)
+	assert.Equal(t, []int{1, 2}, properties.Ports)
+}
@@ -0,0 +1,170 @@
+package property
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// configTreeEntry struct holds the file of a config tree property and its last read content.
+type configTreeEntry struct {
+	path    string
+	value   string
+	modTime time.Time
+	size    int64
+	loaded  bool
+}
+
+// ConfigTreeSource struct represents a source of properties read from a directory tree, such as the secrets
+// and the config maps mounted by Kubernetes. Each file is a property whose name is the path of the file relative
+// to the directory with the separators replaced by dots, and whose value is the content of the file without
+// the trailing line breaks. The hidden files and directories are skipped. The files are reread when they change,
+// but the files added after the source is created are not picked up.
+type ConfigTreeSource struct {
+	name    string
+	dir     string
+	entries map[string]*configTreeEntry
+	mu      sync.Mutex
+}
+
+// NewConfigTreeSource function creates a new ConfigTreeSource with the given name for the given directory.
+// It returns an error if the directory cannot be walked.
+func NewConfigTreeSource(name string, dir string) (*ConfigTreeSource, error) {
+	if strings.TrimSpace(name) == "" {
+		panic("cannot create config tree source with empty or blank name")
+	}
+
+	source := &ConfigTreeSource{
+		name:    name,
+		dir:     dir,
+		entries: make(map[string]*configTreeEntry),
+	}
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != dir && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			return nil
+		}
+
+		relative, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		propertyName := strings.ReplaceAll(filepath.ToSlash(relative), "/", ".")
+		source.entries[propertyName] = &configTreeEntry{
+			path: path,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// Name method returns the name of the source.
+func (s *ConfigTreeSource) Name() string {
+	return s.name
+}
+
+// Source method returns the directory of the source.
+func (s *ConfigTreeSource) Source() any {
+	return s.dir
+}
+
+// ContainsProperty method checks whether the file of the given property name exists.
+func (s *ConfigTreeSource) ContainsProperty(name string) bool {
+	_, ok := s.Property(name)
+	return ok
+}
+
+// Property method returns the content of the file of the given property name.
+// The file is reread if it has changed since it was last read.
+func (s *ConfigTreeSource) Property(name string) (any, bool) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	entry, ok := s.entries[name]
+	if !ok {
+		return nil, false
+	}
+
+	info, err := os.Stat(entry.path)
+	if err != nil || info.IsDir() {
+		return nil, false
+	}
+
+	if !entry.loaded || !info.ModTime().Equal(entry.modTime) || info.Size() != entry.size {
+		content, readErr := os.ReadFile(entry.path)
+		if readErr != nil {
+			return nil, false
+		}
+
+		entry.value = strings.TrimRight(string(content), "\r\n")
+		entry.modTime = info.ModTime()
+		entry.size = info.Size()
+		entry.loaded = true
+	}
+
+	return entry.value, true
+}
+
+// PropertyOrDefault method returns the content of the file of the given property name.
+// If the file does not exist, it returns the default value.
+func (s *ConfigTreeSource) PropertyOrDefault(name string, defaultValue any) any {
+	value, ok := s.Property(name)
+	if !ok {
+		return defaultValue
+	}
+
+	return value
+}
+
+// PropertyOrigin method returns the origin of the given property name, which is located at the path of its file.
+// If there is no file for the property, it returns false.
+func (s *ConfigTreeSource) PropertyOrigin(name string) (Origin, bool) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	entry, ok := s.entries[name]
+	if !ok {
+		return Origin{}, false
+	}
+
+	return Origin{
+		Source:   s.name,
+		Location: entry.path,
+	}, true
+}
+
+// PropertyNames method returns the property names of the files in the directory tree.
+func (s *ConfigTreeSource) PropertyNames() []string {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	names := make([]string, 0, len(s.entries))
+	for name := range s.entries {
+		names = append(names, name)
+	}
+
+	return names
+}
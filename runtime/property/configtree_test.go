@@ -0,0 +1,56 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfigTreeSource_PropertyShouldReturnFileContents(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "db"), 0700))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "..data"), 0700))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "db", "password"), []byte("secret\n"), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "username"), []byte("admin"), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "..data", "username"), []byte("hidden"), 0600))
+
+	source, err := NewConfigTreeSource("anyConfigTree", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "anyConfigTree", source.Name())
+	assert.ElementsMatch(t, []string{"db.password", "username"}, source.PropertyNames())
+
+	value, ok := source.Property("db.password")
+	assert.True(t, ok)
+	assert.Equal(t, "secret", value)
+
+	value, ok = source.Property("username")
+	assert.True(t, ok)
+	assert.Equal(t, "admin", value)
+
+	assert.False(t, source.ContainsProperty("..data.username"))
+
+	assert.Equal(t, Origin{Source: "anyConfigTree", Location: filepath.Join(dir, "db", "password")}, OriginOf(source, "db.password"))
+}
+
+func TestConfigTreeSource_PropertyShouldRereadFileIfItChanges(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	assert.Nil(t, os.WriteFile(path, []byte("first"), 0600))
+
+	source, err := NewConfigTreeSource("anyConfigTree", dir)
+	assert.Nil(t, err)
+
+	value, _ := source.Property("password")
+	assert.Equal(t, "first", value)
+
+	assert.Nil(t, os.WriteFile(path, []byte("second\n"), 0600))
+	assert.Nil(t, os.Chtimes(path, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+
+	value, _ = source.Property("password")
+	assert.Equal(t, "second", value)
+
+	assert.Nil(t, os.Remove(path))
+	assert.False(t, source.ContainsProperty("password"))
+}
@@ -0,0 +1,8 @@
+package property
+
+import "errors"
+
+var (
+	// ErrPropertyNotFound is an error that occurs when a property is not found.
+	ErrPropertyNotFound = errors.New("property not found")
+)
@@ -1,9 +1,14 @@
 package property
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"gopkg.in/yaml.v3"
 	"io"
 	"io/ioutil"
+	"strconv"
+	"strings"
 )
 
 // SourceLoader interface provides methods for loading property sources.
@@ -12,6 +17,13 @@ type SourceLoader interface {
 	Load(name string, reader io.Reader) (Source, error)
 }
 
+// MultiDocumentSourceLoader interface is implemented by the source loaders which can load
+// a separate source for each document in a file, such as the '---' separated documents of a YAML file.
+type MultiDocumentSourceLoader interface {
+	SourceLoader
+	LoadDocuments(name string, reader io.Reader) ([]Source, error)
+}
+
 // YamlSourceLoader struct is an implementation of the SourceLoader interface for YAML files.
 type YamlSourceLoader struct {
 }
@@ -28,18 +40,365 @@ func (l *YamlSourceLoader) FileExtensions() []string {
 }
 
 // Load method loads a property source from a reader.
+// If there is more than one document, only the first one is loaded.
+// The origins of the properties are recorded by the lines and the columns of their values.
 func (l *YamlSourceLoader) Load(name string, reader io.Reader) (Source, error) {
-	loaded := make(map[string]interface{})
-
 	data, err := ioutil.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	err = yaml.Unmarshal(data, &loaded)
+	node := yaml.Node{}
+	err = yaml.Unmarshal(data, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	loaded, origins, err := decodeYamlDocument(name, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMapSource(name, loaded).withOrigins(origins), nil
+}
+
+// LoadDocuments method loads a property source for each '---' separated document from a reader.
+// If there is more than one document, the sources are named after their indexes, such as
+// 'procyon.yml (document #1)', otherwise the source is named as the given name. The empty documents are skipped.
+// The origins of the properties are recorded by the lines and the columns of their values in the file.
+func (l *YamlSourceLoader) LoadDocuments(name string, reader io.Reader) ([]Source, error) {
+	documents := make([]map[string]any, 0)
+	documentOrigins := make([]map[string]Origin, 0)
+	decoder := yaml.NewDecoder(reader)
+
+	for {
+		node := yaml.Node{}
+
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var document map[string]any
+		var origins map[string]Origin
+
+		if err == nil {
+			document, origins, err = decodeYamlDocument(name, &node)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to load document #%d from '%s': %w", len(documents), name, err)
+		}
+
+		documents = append(documents, document)
+		documentOrigins = append(documentOrigins, origins)
+	}
+
+	if len(documents) == 1 {
+		return []Source{NewMapSource(name, documents[0]).withOrigins(documentOrigins[0])}, nil
+	}
+
+	sources := make([]Source, 0, len(documents))
+	for index, document := range documents {
+		if len(document) == 0 {
+			continue
+		}
+
+		source := NewMapSource(fmt.Sprintf("%s (document #%d)", name, index), document)
+		sources = append(sources, source.withOrigins(documentOrigins[index]))
+	}
+
+	return sources, nil
+}
+
+// decodeYamlDocument function decodes the given YAML document node into a map,
+// and returns the origins of its values in the file with the given name by their flattened property names.
+func decodeYamlDocument(name string, node *yaml.Node) (map[string]any, map[string]Origin, error) {
+	document := make(map[string]any)
+	origins := make(map[string]Origin)
+
+	if node.Kind == 0 {
+		return document, origins, nil
+	}
+
+	err := node.Decode(&document)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if document == nil {
+		document = make(map[string]any)
+	}
+
+	yamlOrigins(name, "", node, origins)
+	return document, origins, nil
+}
+
+// yamlOrigins function records the origins of the values under the given node by their paths,
+// such as 'key.name' and 'key.0', in the same way as the maps of a MapSource are flattened.
+func yamlOrigins(name string, path string, node *yaml.Node, origins map[string]Origin) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			yamlOrigins(name, path, child, origins)
+		}
+	case yaml.MappingNode:
+		for index := 0; index+1 < len(node.Content); index += 2 {
+			yamlOrigins(name, joinPath(path, node.Content[index].Value), node.Content[index+1], origins)
+		}
+	case yaml.SequenceNode:
+		for index, child := range node.Content {
+			yamlOrigins(name, indexedName(path, index), child, origins)
+		}
+	case yaml.AliasNode:
+		if node.Alias != nil && node.Alias.Kind != yaml.ScalarNode {
+			yamlOrigins(name, path, node.Alias, origins)
+			return
+		}
+
+		fallthrough
+	default:
+		if path != "" {
+			origins[path] = Origin{
+				Location: name,
+				Line:     node.Line,
+				Column:   node.Column,
+			}
+		}
+	}
+}
+
+// PropertiesSourceLoader struct is an implementation of the SourceLoader interface for .properties files.
+// It supports the '=' and ':' separators, the line continuations, the comments starting with '#' or '!',
+// the escape sequences including the unicode escapes, and the indexed keys such as 'list[0]',
+// which are converted to the keys such as 'list.0'.
+type PropertiesSourceLoader struct {
+}
+
+// NewPropertiesSourceLoader function creates a new PropertiesSourceLoader.
+func NewPropertiesSourceLoader() *PropertiesSourceLoader {
+	return &PropertiesSourceLoader{}
+}
+
+// FileExtensions method returns the file extensions that this loader can handle.
+// In this case, it returns "properties".
+func (l *PropertiesSourceLoader) FileExtensions() []string {
+	return []string{"properties"}
+}
+
+// Load method loads a property source from a reader.
+func (l *PropertiesSourceLoader) Load(name string, reader io.Reader) (Source, error) {
+	data, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewMapSource(name, loaded), nil
+	loaded, origins, err := parseProperties(name, string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load properties from '%s': %w", name, err)
+	}
+
+	return NewMapSource(name, loaded).withOrigins(origins), nil
+}
+
+// parseProperties function parses the given content in the .properties format, and returns the origins
+// of the properties in the file with the given name by the lines and the columns of their keys.
+func parseProperties(name string, content string) (map[string]any, map[string]Origin, error) {
+	loaded := make(map[string]any)
+	origins := make(map[string]Origin)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for index := 0; index < len(lines); index++ {
+		lineNumber := index + 1
+		line := strings.TrimLeft(lines[index], " \t\f")
+		column := len(lines[index]) - len(line) + 1
+
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		for hasContinuation(line) && index+1 < len(lines) {
+			index++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[index], " \t\f")
+		}
+
+		if hasContinuation(line) {
+			line = line[:len(line)-1]
+		}
+
+		rawKey, rawValue := splitProperty(line)
+
+		key, err := unescapeProperty(rawKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid key at line %d: %w", lineNumber, err)
+		}
+
+		value, err := unescapeProperty(rawValue)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value at line %d: %w", lineNumber, err)
+		}
+
+		loaded[indexedKey(key)] = value
+		origins[indexedKey(key)] = Origin{
+			Location: name,
+			Line:     lineNumber,
+			Column:   column,
+		}
+	}
+
+	return loaded, origins, nil
+}
+
+// hasContinuation function checks if the given line ends with an odd number of backslashes.
+func hasContinuation(line string) bool {
+	count := 0
+
+	for index := len(line) - 1; index >= 0 && line[index] == '\\'; index-- {
+		count++
+	}
+
+	return count%2 == 1
+}
+
+// splitProperty function splits the given logical line into its raw key and raw value.
+// The key ends at the first unescaped '=', ':' or whitespace character.
+func splitProperty(line string) (string, string) {
+	keyEnd := len(line)
+
+	for index := 0; index < len(line); index++ {
+		if line[index] == '\\' {
+			index++
+			continue
+		}
+
+		if strings.IndexByte("=: \t\f", line[index]) != -1 {
+			keyEnd = index
+			break
+		}
+	}
+
+	value := strings.TrimLeft(line[keyEnd:], " \t\f")
+	if value != "" && (value[0] == '=' || value[0] == ':') {
+		value = strings.TrimLeft(value[1:], " \t\f")
+	}
+
+	return line[:keyEnd], value
+}
+
+// unescapeProperty function replaces the escape sequences in the given text.
+func unescapeProperty(text string) (string, error) {
+	if !strings.Contains(text, "\\") {
+		return text, nil
+	}
+
+	var builder strings.Builder
+	for index := 0; index < len(text); index++ {
+		if text[index] != '\\' || index+1 == len(text) {
+			builder.WriteByte(text[index])
+			continue
+		}
+
+		index++
+		switch text[index] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'u':
+			if index+4 >= len(text) {
+				return "", fmt.Errorf("malformed unicode escape '%s'", text[index-1:])
+			}
+
+			code, err := strconv.ParseUint(text[index+1:index+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("malformed unicode escape '%s'", text[index-1:index+5])
+			}
+
+			builder.WriteRune(rune(code))
+			index += 4
+		default:
+			builder.WriteByte(text[index])
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// indexedKey function converts the indexes in the given key to the segments, such as 'list[0].name' to 'list.0.name'.
+func indexedKey(key string) string {
+	if !strings.Contains(key, "[") {
+		return key
+	}
+
+	replacer := strings.NewReplacer("[", ".", "]", "")
+	return replacer.Replace(key)
+}
+
+// JsonSourceLoader struct is an implementation of the SourceLoader interface for JSON files.
+// The JSON objects are flattened in the same way as the maps of a MapSource, and the integral
+// numbers are loaded as integers while the other numbers are loaded as floats.
+type JsonSourceLoader struct {
+}
+
+// NewJsonSourceLoader function creates a new JsonSourceLoader.
+func NewJsonSourceLoader() *JsonSourceLoader {
+	return &JsonSourceLoader{}
+}
+
+// FileExtensions method returns the file extensions that this loader can handle.
+// In this case, it returns "json".
+func (l *JsonSourceLoader) FileExtensions() []string {
+	return []string{"json"}
+}
+
+// Load method loads a property source from a reader.
+func (l *JsonSourceLoader) Load(name string, reader io.Reader) (Source, error) {
+	loaded := make(map[string]any)
+
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	err := decoder.Decode(&loaded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load json from '%s': %w", name, err)
+	}
+
+	source := NewMapSource(name, normalizeJson(loaded).(map[string]any))
+
+	origins := make(map[string]Origin, len(source.source))
+	for key := range source.source {
+		origins[key] = Origin{Location: name}
+	}
+
+	return source.withOrigins(origins), nil
+}
+
+// normalizeJson function converts the JSON numbers in the given decoded value to integers or floats.
+func normalizeJson(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			typed[key] = normalizeJson(item)
+		}
+	case []any:
+		for index, item := range typed {
+			typed[index] = normalizeJson(item)
+		}
+	case json.Number:
+		if integer, err := typed.Int64(); err == nil {
+			return int(integer)
+		}
+
+		if float, err := typed.Float64(); err == nil {
+			return float
+		}
+
+		return typed.String()
+	}
+
+	return value
 }
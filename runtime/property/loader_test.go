@@ -0,0 +1,196 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestPropertiesSourceLoader_LoadShouldParsePropertiesFile(t *testing.T) {
+	content := `# comment
+! another comment
+procyon.application.name = anyApplication
+procyon.server.port:8080
+procyon.server.host   localhost
+procyon.message = Hello \
+    World
+procyon.greeting=\u0048\u0069
+procyon.path=C:\\procyon\\config
+procyon\ key\=with\:escapes = anyValue
+procyon.servers[0].host=first
+procyon.servers[1].host=second
+procyon.empty=
+`
+
+	loader := NewPropertiesSourceLoader()
+	assert.Equal(t, []string{"properties"}, loader.FileExtensions())
+
+	source, err := loader.Load("procyon.properties", strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, "procyon.properties", source.Name())
+
+	expected := map[string]any{
+		"procyon.application.name": "anyApplication",
+		"procyon.server.port":      "8080",
+		"procyon.server.host":      "localhost",
+		"procyon.message":          "Hello World",
+		"procyon.greeting":         "Hi",
+		"procyon.path":             "C:\\procyon\\config",
+		"procyon key=with:escapes": "anyValue",
+		"procyon.servers.0.host":   "first",
+		"procyon.servers.1.host":   "second",
+		"procyon.empty":            "",
+	}
+
+	assert.Equal(t, expected, source.Source())
+}
+
+func TestPropertiesSourceLoader_LoadShouldReturnErrorIfUnicodeEscapeIsMalformed(t *testing.T) {
+	_, err := NewPropertiesSourceLoader().Load("procyon.properties", strings.NewReader("procyon.name=\\u00G1"))
+	assert.EqualError(t, err, "failed to load properties from 'procyon.properties': invalid value at line 1: malformed unicode escape '\\u00G1'")
+}
+
+func TestJsonSourceLoader_LoadShouldFlattenJsonDocument(t *testing.T) {
+	content := `{
+		"procyon": {
+			"server": {"port": 8080, "ratio": 0.75, "enabled": true},
+			"hosts": ["localhost", "codnect.io"],
+			"servers": [{"host": "first"}]
+		}
+	}`
+
+	loader := NewJsonSourceLoader()
+	assert.Equal(t, []string{"json"}, loader.FileExtensions())
+
+	source, err := loader.Load("procyon.json", strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{
+		"procyon.server.port":    8080,
+		"procyon.server.ratio":   0.75,
+		"procyon.server.enabled": true,
+		"procyon.hosts.0":        "localhost",
+		"procyon.hosts.1":        "codnect.io",
+		"procyon.servers.0.host": "first",
+	}, source.Source())
+}
+
+func TestJsonSourceLoader_LoadShouldRecordOriginsOfProperties(t *testing.T) {
+	content := `{"procyon": {"server": {"port": 8080}, "hosts": ["localhost"]}}`
+
+	source, err := NewJsonSourceLoader().Load("config/procyon.json", strings.NewReader(content))
+	assert.Nil(t, err)
+
+	assert.Equal(t, Origin{Source: "config/procyon.json", Location: "config/procyon.json"},
+		OriginOf(source, "procyon.server.port"))
+	assert.Equal(t, Origin{Source: "config/procyon.json", Location: "config/procyon.json"},
+		OriginOf(source, "procyon.hosts.0"))
+}
+
+func TestJsonSourceLoader_LoadShouldReturnErrorIfDocumentIsInvalid(t *testing.T) {
+	_, err := NewJsonSourceLoader().Load("procyon.json", strings.NewReader("{"))
+	assert.EqualError(t, err, "failed to load json from 'procyon.json': unexpected EOF")
+}
+
+func TestYamlSourceLoader_LoadShouldFlattenListItemsToTheirFields(t *testing.T) {
+	content := `procyon:
+  servers:
+    - host: first
+      ports: [8080, 8081]
+    - second
+`
+
+	source, err := NewYamlSourceLoader().Load("procyon.yml", strings.NewReader(content))
+	assert.Nil(t, err)
+
+	assert.ElementsMatch(t, []string{
+		"procyon.servers.0.host",
+		"procyon.servers.0.ports.0",
+		"procyon.servers.0.ports.1",
+		"procyon.servers.1",
+	}, source.PropertyNames())
+	assert.False(t, source.ContainsProperty("procyon.servers.0"))
+
+	value, ok := source.Property("procyon.servers.0.ports.1")
+	assert.True(t, ok)
+	assert.Equal(t, 8081, value)
+}
+
+func TestYamlSourceLoader_LoadDocumentsShouldLoadSourceForEachDocument(t *testing.T) {
+	content := `procyon:
+  application:
+    name: anyApplication
+---
+procyon:
+  config:
+    activate:
+      on-profile: prod & !eu
+  server:
+    port: 8080
+---
+---
+procyon.server.port: 9090
+`
+
+	loader := NewYamlSourceLoader()
+	sources, err := loader.LoadDocuments("procyon.yml", strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Len(t, sources, 3)
+
+	assert.Equal(t, "procyon.yml (document #0)", sources[0].Name())
+	assert.Equal(t, "procyon.yml (document #1)", sources[1].Name())
+	assert.Equal(t, "procyon.yml (document #3)", sources[2].Name())
+
+	value, ok := sources[1].Property("procyon.config.activate.on-profile")
+	assert.True(t, ok)
+	assert.Equal(t, "prod & !eu", value)
+
+	value, ok = sources[2].Property("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, 9090, value)
+}
+
+func TestYamlSourceLoader_LoadDocumentsShouldUseGivenNameForSingleDocument(t *testing.T) {
+	loader := NewYamlSourceLoader()
+	sources, err := loader.LoadDocuments("procyon.yml", strings.NewReader("procyon.server.port: 8080"))
+	assert.Nil(t, err)
+	assert.Len(t, sources, 1)
+	assert.Equal(t, "procyon.yml", sources[0].Name())
+}
+
+func TestYamlSourceLoader_LoadDocumentsShouldRecordOriginsOfValues(t *testing.T) {
+	content := `procyon:
+  application:
+    name: anyApplication
+---
+procyon:
+  servers:
+    - host: first
+    - second
+  server.port: 8080
+`
+
+	sources, err := NewYamlSourceLoader().LoadDocuments("config/procyon.yml", strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Len(t, sources, 2)
+
+	assert.Equal(t, Origin{Source: "config/procyon.yml (document #0)", Location: "config/procyon.yml", Line: 3, Column: 11},
+		OriginOf(sources[0], "procyon.application.name"))
+	assert.Equal(t, Origin{Source: "config/procyon.yml (document #1)", Location: "config/procyon.yml", Line: 7, Column: 13},
+		OriginOf(sources[1], "procyon.servers.0.host"))
+	assert.Equal(t, Origin{Source: "config/procyon.yml (document #1)", Location: "config/procyon.yml", Line: 8, Column: 7},
+		OriginOf(sources[1], "procyon.servers.1"))
+	assert.Equal(t, Origin{Source: "config/procyon.yml (document #1)", Location: "config/procyon.yml", Line: 9, Column: 16},
+		OriginOf(sources[1], "procyon.server.port"))
+}
+
+func TestPropertiesSourceLoader_LoadShouldRecordOriginsOfKeys(t *testing.T) {
+	content := "# comment\nprocyon.server.port=8080\n  procyon.message = Hello \\\n    World\n"
+
+	source, err := NewPropertiesSourceLoader().Load("procyon.properties", strings.NewReader(content))
+	assert.Nil(t, err)
+
+	assert.Equal(t, Origin{Source: "procyon.properties", Location: "procyon.properties", Line: 2, Column: 1},
+		OriginOf(source, "procyon.server.port"))
+	assert.Equal(t, Origin{Source: "procyon.properties", Location: "procyon.properties", Line: 3, Column: 3},
+		OriginOf(source, "procyon.message"))
+}
@@ -6,9 +6,11 @@ import (
 )
 
 // MapSource struct represents a source of properties that are stored in a map.
+// The origins of the properties are reported if they are recorded by the loader of the source.
 type MapSource struct {
-	name   string
-	source map[string]any
+	name    string
+	source  map[string]any
+	origins map[string]Origin
 }
 
 // NewMapSource function creates a new MapSource with the given name and key-value pair map.
@@ -67,6 +69,24 @@ func (m *MapSource) PropertyOrDefault(name string, defaultValue any) any {
 	return value
 }
 
+// PropertyOrigin returns the origin of the given property name in the source.
+// If the origin of the property is not recorded, it returns false.
+func (m *MapSource) PropertyOrigin(name string) (Origin, bool) {
+	origin, exists := m.origins[name]
+	if !exists {
+		return Origin{}, false
+	}
+
+	origin.Source = m.name
+	return origin, true
+}
+
+// withOrigins method sets the origins of the properties in the source.
+func (m *MapSource) withOrigins(origins map[string]Origin) *MapSource {
+	m.origins = origins
+	return m
+}
+
 // PropertyNames returns the property names in the source.
 func (m *MapSource) PropertyNames() []string {
 	names := make([]string, 0)
@@ -84,21 +104,26 @@ func flatMap(m map[string]interface{}) map[string]interface{} {
 	flattenMap := map[string]interface{}{}
 
 	for key, value := range m {
-		switch child := value.(type) {
-		case map[string]interface{}:
-			nm := flatMap(child)
-
-			for nk, nv := range nm {
-				flattenMap[key+"."+nk] = nv
-			}
-		case []interface{}:
-			for i := 0; i < len(child); i++ {
-				flattenMap[key+"."+strconv.Itoa(i)] = child[i]
-			}
-		default:
-			flattenMap[key] = value
-		}
+		flattenValue(key, value, flattenMap)
 	}
 
 	return flattenMap
 }
+
+// flattenValue function adds the given value to the given flatten map by the given key.
+// The items of the nested maps and slices are added by their paths, such as 'key.name' and 'key.0'.
+// The composite slice items are flattened as well, so that only their fields are added, such as 'key.0.name'.
+func flattenValue(key string, value interface{}, flattenMap map[string]interface{}) {
+	switch child := value.(type) {
+	case map[string]interface{}:
+		for nk, nv := range child {
+			flattenValue(key+"."+nk, nv, flattenMap)
+		}
+	case []interface{}:
+		for i := 0; i < len(child); i++ {
+			flattenValue(key+"."+strconv.Itoa(i), child[i], flattenMap)
+		}
+	default:
+		flattenMap[key] = value
+	}
+}
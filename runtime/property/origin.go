@@ -0,0 +1,58 @@
+package property
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Origin struct describes where the value of a property comes from.
+type Origin struct {
+	Source   string // The name of the source which supplies the value.
+	Location string // The location of the value in the source, such as a file path or an environment variable.
+	Line     int    // The line of the value starting from 1, or 0 if it is unknown.
+	Column   int    // The column of the value starting from 1, or 0 if it is unknown.
+}
+
+// String method returns the description of the origin, such as "source 'procyon.yml' (procyon.yml:3:9)".
+// The location is omitted if it is unknown, and the column is omitted if the line is unknown.
+func (o Origin) String() string {
+	if o.Location == "" {
+		return fmt.Sprintf("source '%s'", o.Source)
+	}
+
+	location := o.Location
+	if o.Line > 0 {
+		location += ":" + strconv.Itoa(o.Line)
+
+		if o.Column > 0 {
+			location += ":" + strconv.Itoa(o.Column)
+		}
+	}
+
+	return fmt.Sprintf("source '%s' (%s)", o.Source, location)
+}
+
+// OriginLookup interface is implemented by the sources which can report the origins of their properties.
+type OriginLookup interface {
+	// PropertyOrigin returns the origin of the given property name in the source.
+	// If the property does not exist or its origin is unknown, it returns false.
+	PropertyOrigin(name string) (Origin, bool)
+}
+
+// OriginOf function returns the origin of the given property name in the given source.
+// If the source cannot report the origin, the returned origin names only the source.
+func OriginOf(source Source, name string) Origin {
+	if lookup, ok := source.(OriginLookup); ok {
+		if origin, found := lookup.PropertyOrigin(name); found {
+			if origin.Source == "" {
+				origin.Source = source.Name()
+			}
+
+			return origin
+		}
+	}
+
+	return Origin{
+		Source: source.Name(),
+	}
+}
@@ -0,0 +1,24 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOrigin_StringShouldDescribeOrigin(t *testing.T) {
+	assert.Equal(t, "source 'anySource'", Origin{Source: "anySource"}.String())
+	assert.Equal(t, "source 'systemEnvironment' (environment variable 'DB_PORT')",
+		Origin{Source: "systemEnvironment", Location: "environment variable 'DB_PORT'"}.String())
+	assert.Equal(t, "source '.env' (.env:3)", Origin{Source: ".env", Location: ".env", Line: 3}.String())
+	assert.Equal(t, "source 'procyon.yml' (config/procyon.yml:3:11)",
+		Origin{Source: "procyon.yml", Location: "config/procyon.yml", Line: 3, Column: 11}.String())
+}
+
+func TestOriginOf_ShouldReturnSourceNameIfOriginIsUnknown(t *testing.T) {
+	source := NewMapSource("anySource", map[string]any{
+		"procyon.server.port": 8080,
+	})
+
+	assert.Equal(t, Origin{Source: "anySource"}, OriginOf(source, "procyon.server.port"))
+	assert.Equal(t, Origin{Source: "anySource"}, OriginOf(source, "procyon.server.host"))
+}
@@ -0,0 +1,267 @@
+package property
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultPlaceholderPrefix is the default prefix of placeholders.
+	DefaultPlaceholderPrefix = "${"
+	// DefaultPlaceholderSuffix is the default suffix of placeholders.
+	DefaultPlaceholderSuffix = "}"
+	// DefaultPlaceholderSeparator is the default separator between the names and the default values of placeholders.
+	DefaultPlaceholderSeparator = ":"
+	// DefaultPlaceholderEscape is the default escape character which prevents a placeholder from being resolved.
+	DefaultPlaceholderEscape = '\\'
+)
+
+// PlaceholderOption is a function type that modifies a PlaceholderParser.
+type PlaceholderOption func(parser *PlaceholderParser)
+
+// WithPlaceholderPrefix sets the prefix of placeholders.
+func WithPlaceholderPrefix(prefix string) PlaceholderOption {
+	return func(parser *PlaceholderParser) {
+		parser.prefix = prefix
+	}
+}
+
+// WithPlaceholderSuffix sets the suffix of placeholders.
+func WithPlaceholderSuffix(suffix string) PlaceholderOption {
+	return func(parser *PlaceholderParser) {
+		parser.suffix = suffix
+	}
+}
+
+// WithPlaceholderSeparator sets the separator between the names and the default values of placeholders.
+// An empty separator disables the default values.
+func WithPlaceholderSeparator(separator string) PlaceholderOption {
+	return func(parser *PlaceholderParser) {
+		parser.separator = separator
+	}
+}
+
+// WithPlaceholderEscape sets the escape character which prevents a placeholder from being resolved.
+// A zero escape character disables escaping.
+func WithPlaceholderEscape(escape rune) PlaceholderOption {
+	return func(parser *PlaceholderParser) {
+		parser.escape = escape
+	}
+}
+
+// PlaceholderLookup is a function type that returns the value of the placeholder with the given name.
+type PlaceholderLookup func(name string) (string, bool)
+
+// PlaceholderParser struct replaces the placeholders such as '${name}' in texts.
+// A placeholder may have a default value which is used when the placeholder cannot be resolved,
+// such as '${name:default}'. The placeholders may be nested, such as '${db.url:${DB_URL}}' or '${${env}.url}',
+// and the resolved values are resolved recursively. A placeholder prefixed with the escape character,
+// such as '\${name}', is not resolved and the escape character is removed.
+type PlaceholderParser struct {
+	prefix    string
+	suffix    string
+	separator string
+	escape    rune
+	reserved  []string
+	mu        sync.RWMutex
+}
+
+// NewPlaceholderParser function creates a new PlaceholderParser with the given options.
+func NewPlaceholderParser(options ...PlaceholderOption) *PlaceholderParser {
+	parser := &PlaceholderParser{
+		prefix:    DefaultPlaceholderPrefix,
+		suffix:    DefaultPlaceholderSuffix,
+		separator: DefaultPlaceholderSeparator,
+		escape:    DefaultPlaceholderEscape,
+	}
+
+	for _, option := range options {
+		option(parser)
+	}
+
+	if parser.prefix == "" || parser.suffix == "" {
+		panic("empty placeholder prefix or suffix")
+	}
+
+	return parser
+}
+
+// Replace method replaces the placeholders in the given text with the values returned by the given lookup function.
+// If ignoreUnresolvable is true, the unresolvable placeholders are left as they are, otherwise an error is returned.
+// It returns an error if a placeholder refers to itself directly or indirectly.
+func (p *PlaceholderParser) Replace(text string, lookup PlaceholderLookup, ignoreUnresolvable bool) (string, error) {
+	if lookup == nil {
+		return "", fmt.Errorf("nil lookup")
+	}
+
+	return p.replace(text, lookup, ignoreUnresolvable, make(map[string]struct{}))
+}
+
+// replace method replaces the placeholders in the given text. The visiting map holds
+// the names of the placeholders being resolved, so that circular references are detected.
+func (p *PlaceholderParser) replace(text string, lookup PlaceholderLookup, ignoreUnresolvable bool, visiting map[string]struct{}) (string, error) {
+	var builder strings.Builder
+
+	for index := 0; index < len(text); {
+		if p.isEscaped(text, index) {
+			builder.WriteString(p.prefix)
+			index += len(string(p.escape)) + len(p.prefix)
+			continue
+		}
+
+		if !strings.HasPrefix(text[index:], p.prefix) {
+			builder.WriteByte(text[index])
+			index++
+			continue
+		}
+
+		end := p.findSuffix(text, index+len(p.prefix))
+		if end == -1 {
+			builder.WriteString(text[index:])
+			break
+		}
+
+		placeholder := text[index+len(p.prefix) : end]
+		value, err := p.resolvePlaceholder(placeholder, lookup, ignoreUnresolvable, visiting)
+		if err != nil {
+			return "", err
+		}
+
+		builder.WriteString(value)
+		index = end + len(p.suffix)
+	}
+
+	return builder.String(), nil
+}
+
+// resolvePlaceholder method resolves the given placeholder, which is the text between the prefix and the suffix.
+func (p *PlaceholderParser) resolvePlaceholder(placeholder string, lookup PlaceholderLookup, ignoreUnresolvable bool, visiting map[string]struct{}) (string, error) {
+	name := placeholder
+	defaultValue := ""
+	hasDefault := false
+
+	if separatorIndex := p.findSeparator(placeholder); separatorIndex != -1 {
+		name = placeholder[:separatorIndex]
+		defaultValue = placeholder[separatorIndex+len(p.separator):]
+		hasDefault = true
+	}
+
+	name, err := p.replace(name, lookup, ignoreUnresolvable, visiting)
+	if err != nil {
+		return "", err
+	}
+
+	if value, ok := lookup(name); ok {
+		return p.resolveValue(name, value, lookup, ignoreUnresolvable, visiting)
+	}
+
+	if hasDefault {
+		return p.replace(defaultValue, lookup, ignoreUnresolvable, visiting)
+	}
+
+	if ignoreUnresolvable {
+		return p.prefix + placeholder + p.suffix, nil
+	}
+
+	return "", fmt.Errorf("could not resolve placeholder '%s%s%s'", p.prefix, placeholder, p.suffix)
+}
+
+// resolveValue method resolves the placeholders in the value of the placeholder with the given name.
+func (p *PlaceholderParser) resolveValue(name string, value string, lookup PlaceholderLookup, ignoreUnresolvable bool, visiting map[string]struct{}) (string, error) {
+	if _, ok := visiting[name]; ok {
+		return "", fmt.Errorf("circular placeholder reference '%s'", name)
+	}
+
+	visiting[name] = struct{}{}
+	defer delete(visiting, name)
+
+	return p.replace(value, lookup, ignoreUnresolvable, visiting)
+}
+
+// isEscaped method checks if there is an escaped placeholder prefix at the given index.
+func (p *PlaceholderParser) isEscaped(text string, index int) bool {
+	if p.escape == 0 {
+		return false
+	}
+
+	escape := string(p.escape)
+	return strings.HasPrefix(text[index:], escape) && strings.HasPrefix(text[index+len(escape):], p.prefix)
+}
+
+// findSuffix method returns the index of the suffix matching the prefix ending at the given index.
+// It returns -1 if there is no matching suffix.
+func (p *PlaceholderParser) findSuffix(text string, start int) int {
+	depth := 0
+
+	for index := start; index < len(text); {
+		switch {
+		case p.isEscaped(text, index):
+			index += len(string(p.escape)) + len(p.prefix)
+		case strings.HasPrefix(text[index:], p.suffix):
+			if depth == 0 {
+				return index
+			}
+
+			depth--
+			index += len(p.suffix)
+		case strings.HasPrefix(text[index:], p.prefix):
+			depth++
+			index += len(p.prefix)
+		default:
+			index++
+		}
+	}
+
+	return -1
+}
+
+// reservePrefix method reserves the given placeholder name prefix, such as 'env:', so that
+// the separator in the reserved prefix is not considered as the separator of a default value.
+func (p *PlaceholderParser) reservePrefix(prefix string) {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	p.reserved = append(p.reserved, prefix)
+}
+
+// reservedPrefixLength method returns the length of the reserved prefix which the given placeholder starts with.
+func (p *PlaceholderParser) reservedPrefixLength(placeholder string) int {
+	defer p.mu.RUnlock()
+	p.mu.RLock()
+
+	length := 0
+	for _, prefix := range p.reserved {
+		if strings.HasPrefix(placeholder, prefix) && len(prefix) > length {
+			length = len(prefix)
+		}
+	}
+
+	return length
+}
+
+// findSeparator method returns the index of the first separator which is neither in a nested placeholder
+// nor in a reserved prefix. It returns -1 if there is no such separator.
+func (p *PlaceholderParser) findSeparator(placeholder string) int {
+	if p.separator == "" {
+		return -1
+	}
+
+	for index := p.reservedPrefixLength(placeholder); index < len(placeholder); {
+		switch {
+		case strings.HasPrefix(placeholder[index:], p.prefix):
+			end := p.findSuffix(placeholder, index+len(p.prefix))
+			if end == -1 {
+				return -1
+			}
+
+			index = end + len(p.suffix)
+		case strings.HasPrefix(placeholder[index:], p.separator):
+			return index
+		default:
+			index++
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,174 @@
+package property
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvPlaceholderPrefix is the prefix of the placeholders resolved from the environment variables.
+	EnvPlaceholderPrefix = "env:"
+	// FilePlaceholderPrefix is the prefix of the placeholders resolved from the contents of the files.
+	FilePlaceholderPrefix = "file:"
+	// Base64PlaceholderPrefix is the prefix of the placeholders resolved by decoding base64 values.
+	Base64PlaceholderPrefix = "base64:"
+	// RandomPlaceholderPrefix is the prefix of the placeholders resolved to random values.
+	RandomPlaceholderPrefix = "random."
+)
+
+// PlaceholderResolver interface resolves the placeholders starting with a specific prefix, such as '${env:HOME}'.
+type PlaceholderResolver interface {
+	// Prefix returns the prefix of the placeholders resolved by the resolver.
+	Prefix() string
+	// ResolvePlaceholder resolves the given placeholder name, which does not contain the prefix.
+	// It returns false if the placeholder cannot be resolved.
+	ResolvePlaceholder(name string) (string, bool)
+}
+
+// EnvPlaceholderResolver struct resolves the placeholders such as '${env:HOME}' from the environment variables.
+type EnvPlaceholderResolver struct {
+}
+
+// NewEnvPlaceholderResolver function creates a new EnvPlaceholderResolver.
+func NewEnvPlaceholderResolver() *EnvPlaceholderResolver {
+	return &EnvPlaceholderResolver{}
+}
+
+// Prefix method returns the 'env:' prefix.
+func (r *EnvPlaceholderResolver) Prefix() string {
+	return EnvPlaceholderPrefix
+}
+
+// ResolvePlaceholder method returns the value of the environment variable with the given name.
+func (r *EnvPlaceholderResolver) ResolvePlaceholder(name string) (string, bool) {
+	return os.LookupEnv(name)
+}
+
+// FilePlaceholderResolver struct resolves the placeholders such as '${file:/run/secrets/db_password}'
+// from the contents of the files. The trailing line breaks of the contents are removed.
+type FilePlaceholderResolver struct {
+}
+
+// NewFilePlaceholderResolver function creates a new FilePlaceholderResolver.
+func NewFilePlaceholderResolver() *FilePlaceholderResolver {
+	return &FilePlaceholderResolver{}
+}
+
+// Prefix method returns the 'file:' prefix.
+func (r *FilePlaceholderResolver) Prefix() string {
+	return FilePlaceholderPrefix
+}
+
+// ResolvePlaceholder method returns the content of the file at the given path.
+func (r *FilePlaceholderResolver) ResolvePlaceholder(name string) (string, bool) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", false
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), true
+}
+
+// Base64PlaceholderResolver struct resolves the placeholders such as '${base64:aGVsbG8=}' by decoding the base64 values.
+type Base64PlaceholderResolver struct {
+}
+
+// NewBase64PlaceholderResolver function creates a new Base64PlaceholderResolver.
+func NewBase64PlaceholderResolver() *Base64PlaceholderResolver {
+	return &Base64PlaceholderResolver{}
+}
+
+// Prefix method returns the 'base64:' prefix.
+func (r *Base64PlaceholderResolver) Prefix() string {
+	return Base64PlaceholderPrefix
+}
+
+// ResolvePlaceholder method decodes the given base64 value.
+func (r *Base64PlaceholderResolver) ResolvePlaceholder(name string) (string, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(name)
+	if err != nil {
+		return "", false
+	}
+
+	return string(decoded), true
+}
+
+// RandomPlaceholderResolver struct resolves the placeholders such as '${random.uuid}' and '${random.int(10,20)}'
+// to random values. The supported names are 'int', 'long', 'uuid', 'value' and the ranges of 'int' and 'long'.
+// The values are generated by the given RandomSource, so that they are reproducible if the source is seeded.
+type RandomPlaceholderResolver struct {
+	source *RandomSource
+}
+
+// NewRandomPlaceholderResolver function creates a new RandomPlaceholderResolver with the given random source.
+func NewRandomPlaceholderResolver(source *RandomSource) *RandomPlaceholderResolver {
+	if source == nil {
+		panic("nil source")
+	}
+
+	return &RandomPlaceholderResolver{
+		source: source,
+	}
+}
+
+// Prefix method returns the 'random.' prefix.
+func (r *RandomPlaceholderResolver) Prefix() string {
+	return RandomPlaceholderPrefix
+}
+
+// ResolvePlaceholder method returns a random value for the given name.
+func (r *RandomPlaceholderResolver) ResolvePlaceholder(name string) (string, bool) {
+	value, ok := r.source.Property(RandomPlaceholderPrefix + name)
+	if !ok {
+		return "", false
+	}
+
+	return fmt.Sprint(value), true
+}
+
+// CachingPlaceholderResolver struct is a PlaceholderResolver decorator which caches
+// the values resolved by the underlying resolver, so that each placeholder is resolved only once.
+type CachingPlaceholderResolver struct {
+	resolver PlaceholderResolver
+	cache    map[string]string
+	mu       sync.Mutex
+}
+
+// NewCachingPlaceholderResolver function creates a new CachingPlaceholderResolver for the given resolver.
+func NewCachingPlaceholderResolver(resolver PlaceholderResolver) *CachingPlaceholderResolver {
+	if resolver == nil {
+		panic("nil resolver")
+	}
+
+	return &CachingPlaceholderResolver{
+		resolver: resolver,
+		cache:    make(map[string]string),
+	}
+}
+
+// Prefix method returns the prefix of the underlying resolver.
+func (r *CachingPlaceholderResolver) Prefix() string {
+	return r.resolver.Prefix()
+}
+
+// ResolvePlaceholder method returns the cached value of the given placeholder name.
+// If there is no cached value, it is resolved by the underlying resolver and cached.
+// The unresolvable placeholders are not cached.
+func (r *CachingPlaceholderResolver) ResolvePlaceholder(name string) (string, bool) {
+	defer r.mu.Unlock()
+	r.mu.Lock()
+
+	if value, ok := r.cache[name]; ok {
+		return value, true
+	}
+
+	value, ok := r.resolver.ResolvePlaceholder(name)
+	if ok {
+		r.cache[name] = value
+	}
+
+	return value, ok
+}
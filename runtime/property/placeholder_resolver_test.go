@@ -0,0 +1,104 @@
+package property
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+type anyCountingPlaceholderResolver struct {
+	calls int
+}
+
+func (r *anyCountingPlaceholderResolver) Prefix() string {
+	return "count:"
+}
+
+func (r *anyCountingPlaceholderResolver) ResolvePlaceholder(name string) (string, bool) {
+	r.calls++
+	return name, name != "missing"
+}
+
+func TestEnvPlaceholderResolver_ResolvePlaceholderShouldReturnEnvironmentVariable(t *testing.T) {
+	t.Setenv("ANY_PLACEHOLDER_VARIABLE", "anyValue")
+	resolver := NewEnvPlaceholderResolver()
+
+	value, ok := resolver.ResolvePlaceholder("ANY_PLACEHOLDER_VARIABLE")
+	assert.True(t, ok)
+	assert.Equal(t, "anyValue", value)
+
+	_, ok = resolver.ResolvePlaceholder("MISSING_PLACEHOLDER_VARIABLE")
+	assert.False(t, ok)
+}
+
+func TestFilePlaceholderResolver_ResolvePlaceholderShouldReturnFileContent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db_password")
+	assert.Nil(t, os.WriteFile(path, []byte("secret\n"), 0600))
+	resolver := NewFilePlaceholderResolver()
+
+	value, ok := resolver.ResolvePlaceholder(path)
+	assert.True(t, ok)
+	assert.Equal(t, "secret", value)
+
+	_, ok = resolver.ResolvePlaceholder(filepath.Join(t.TempDir(), "missing"))
+	assert.False(t, ok)
+}
+
+func TestBase64PlaceholderResolver_ResolvePlaceholderShouldDecodeValue(t *testing.T) {
+	resolver := NewBase64PlaceholderResolver()
+
+	value, ok := resolver.ResolvePlaceholder("cHJvY3lvbg==")
+	assert.True(t, ok)
+	assert.Equal(t, "procyon", value)
+
+	_, ok = resolver.ResolvePlaceholder("not base64")
+	assert.False(t, ok)
+}
+
+func TestRandomPlaceholderResolver_ResolvePlaceholderShouldReturnRandomValues(t *testing.T) {
+	resolver := NewRandomPlaceholderResolver(NewRandomSource())
+
+	value, ok := resolver.ResolvePlaceholder("uuid")
+	assert.True(t, ok)
+	assert.Regexp(t, regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"), value)
+
+	value, ok = resolver.ResolvePlaceholder("int(10,11)")
+	assert.True(t, ok)
+	assert.Equal(t, "10", value)
+
+	_, ok = resolver.ResolvePlaceholder("int(20,10)")
+	assert.False(t, ok)
+}
+
+func TestRandomPlaceholderResolver_ResolvePlaceholderShouldGenerateValuesByGivenSource(t *testing.T) {
+	resolver := NewRandomPlaceholderResolver(NewRandomSourceWithSeed(42))
+	source := NewRandomSourceWithSeed(42)
+
+	for _, name := range []string{"int", "long", "uuid", "value", "int(8000,9000)"} {
+		value, ok := resolver.ResolvePlaceholder(name)
+		assert.True(t, ok)
+
+		expected, _ := source.Property(RandomPlaceholderPrefix + name)
+		assert.Equal(t, fmt.Sprint(expected), value)
+	}
+}
+
+func TestCachingPlaceholderResolver_ResolvePlaceholderShouldResolveEachPlaceholderOnce(t *testing.T) {
+	counting := &anyCountingPlaceholderResolver{}
+	resolver := NewCachingPlaceholderResolver(counting)
+
+	assert.Equal(t, "count:", resolver.Prefix())
+
+	for i := 0; i < 3; i++ {
+		value, ok := resolver.ResolvePlaceholder("any")
+		assert.True(t, ok)
+		assert.Equal(t, "any", value)
+	}
+
+	_, ok := resolver.ResolvePlaceholder("missing")
+	assert.False(t, ok)
+	assert.Equal(t, 2, counting.calls)
+}
@@ -0,0 +1,103 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func anyLookup(values map[string]string) PlaceholderLookup {
+	return func(name string) (string, bool) {
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+func TestPlaceholderParser_ReplaceShouldResolvePlaceholders(t *testing.T) {
+	parser := NewPlaceholderParser()
+	lookup := anyLookup(map[string]string{
+		"db.host": "localhost",
+		"db.port": "5432",
+		"db.url":  "jdbc://${db.host}:${db.port}",
+		"env":     "prod",
+		"prod.db": "prod-db",
+	})
+
+	testCases := []struct {
+		text     string
+		expected string
+	}{
+		{"${db.host}", "localhost"},
+		{"url=${db.url}", "url=jdbc://localhost:5432"},
+		{"${db.user:root}", "root"},
+		{"${db.user:${db.host}}", "localhost"},
+		{"${db.user:}", ""},
+		{"${${env}.db}", "prod-db"},
+		{"${db.host:${MISSING}}", "localhost"},
+		{"\\${db.host} and ${db.port}", "${db.host} and 5432"},
+		{"${db.host", "${db.host"},
+		{"$db.host", "$db.host"},
+	}
+
+	for _, testCase := range testCases {
+		result, err := parser.Replace(testCase.text, lookup, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expected, result)
+	}
+}
+
+func TestPlaceholderParser_ReplaceShouldReturnErrorIfPlaceholderCannotBeResolved(t *testing.T) {
+	parser := NewPlaceholderParser()
+
+	_, err := parser.Replace("jdbc://${db.host}", anyLookup(map[string]string{}), false)
+	assert.EqualError(t, err, "could not resolve placeholder '${db.host}'")
+}
+
+func TestPlaceholderParser_ReplaceShouldLeaveUnresolvablePlaceholdersIfIgnored(t *testing.T) {
+	parser := NewPlaceholderParser()
+
+	result, err := parser.Replace("${db.host}:${db.port}", anyLookup(map[string]string{"db.port": "5432"}), true)
+	assert.Nil(t, err)
+	assert.Equal(t, "${db.host}:5432", result)
+}
+
+func TestPlaceholderParser_ReplaceShouldReturnErrorIfPlaceholdersReferToEachOther(t *testing.T) {
+	parser := NewPlaceholderParser()
+	lookup := anyLookup(map[string]string{
+		"a": "${b}",
+		"b": "${c}",
+		"c": "${a}",
+	})
+
+	_, err := parser.Replace("${a}", lookup, true)
+	assert.EqualError(t, err, "circular placeholder reference 'a'")
+}
+
+func TestPlaceholderParser_ReplaceShouldUseConfiguredSyntax(t *testing.T) {
+	parser := NewPlaceholderParser(
+		WithPlaceholderPrefix("#{"),
+		WithPlaceholderSuffix("}"),
+		WithPlaceholderSeparator("?"),
+		WithPlaceholderEscape('!'),
+	)
+
+	result, err := parser.Replace("#{db.host}:#{db.port?5432} !#{db.host} ${db.host}", anyLookup(map[string]string{
+		"db.host": "localhost",
+	}), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "localhost:5432 #{db.host} ${db.host}", result)
+}
+
+func TestPlaceholderParser_ReplaceShouldNotSplitReservedPrefixes(t *testing.T) {
+	parser := NewPlaceholderParser()
+	parser.reservePrefix("env:")
+
+	lookup := anyLookup(map[string]string{
+		"env:HOME": "/home/any",
+	})
+
+	result, err := parser.Replace("${env:HOME}|${env:MISSING:/tmp}|${other:value}", lookup, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "/home/any|/tmp|value", result)
+}
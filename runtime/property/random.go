@@ -0,0 +1,223 @@
+package property
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// randomGenerator struct generates the random values of the names such as 'int', 'int(10,20)',
+// 'long', 'long[0,100]', 'uuid' and 'value'. The ranges include their lower bounds and exclude their upper bounds,
+// and a range having a single bound, such as 'int(10)', starts from zero.
+type randomGenerator struct {
+	random *rand.Rand
+	mu     sync.Mutex
+}
+
+// newRandomGenerator function creates a new randomGenerator with the given seed.
+func newRandomGenerator(seed uint64) *randomGenerator {
+	return &randomGenerator{
+		random: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// generate method returns a random value for the given name.
+// It returns false if the name is not supported or its range is invalid.
+func (g *randomGenerator) generate(name string) (any, bool) {
+	defer g.mu.Unlock()
+	g.mu.Lock()
+
+	switch name {
+	case "int":
+		return int(g.random.Int32()), true
+	case "long":
+		return g.random.Int64(), true
+	case "uuid":
+		return g.uuid(), true
+	case "value":
+		return hex.EncodeToString(g.bytes(16)), true
+	}
+
+	kind, bounds, ok := parseSupportedRandomRange(name)
+	if !ok {
+		return nil, false
+	}
+
+	value := bounds[0] + g.random.Int64N(bounds[1]-bounds[0])
+	if kind == "int" {
+		return int(value), true
+	}
+
+	return value, true
+}
+
+// supports method checks if the given name is supported without generating a random value.
+func (g *randomGenerator) supports(name string) bool {
+	switch name {
+	case "int", "long", "uuid", "value":
+		return true
+	}
+
+	_, _, ok := parseSupportedRandomRange(name)
+	return ok
+}
+
+// bytes method returns the given number of random bytes.
+func (g *randomGenerator) bytes(count int) []byte {
+	result := make([]byte, count)
+
+	for index := range result {
+		result[index] = byte(g.random.UintN(256))
+	}
+
+	return result
+}
+
+// uuid method returns a random version 4 UUID.
+func (g *randomGenerator) uuid() string {
+	value := g.bytes(16)
+	value[6] = (value[6] & 0x0f) | 0x40
+	value[8] = (value[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", value[0:4], value[4:6], value[6:8], value[8:10], value[10:])
+}
+
+// parseSupportedRandomRange function parses the names having ranges such as 'int(10,20)' or 'long[5]'
+// and checks if their kinds and bounds are supported.
+func parseSupportedRandomRange(name string) (string, [2]int64, bool) {
+	kind, bounds, ok := parseRandomRange(name)
+	if !ok {
+		return "", bounds, false
+	}
+
+	switch kind {
+	case "int":
+		if bounds[0] < -1<<31 || bounds[1] > 1<<31 {
+			return "", bounds, false
+		}
+
+		return kind, bounds, true
+	case "long":
+		return kind, bounds, true
+	}
+
+	return "", bounds, false
+}
+
+// parseRandomRange function parses the names having ranges such as 'int(10,20)' or 'long[5]'.
+// It returns the kind of the name and its lower and upper bounds.
+func parseRandomRange(name string) (string, [2]int64, bool) {
+	var bounds [2]int64
+
+	start := strings.IndexAny(name, "([")
+	if start == -1 || len(name) < start+2 {
+		return "", bounds, false
+	}
+
+	if closing := name[len(name)-1]; (name[start] == '(' && closing != ')') || (name[start] == '[' && closing != ']') {
+		return "", bounds, false
+	}
+
+	parts := strings.Split(name[start+1:len(name)-1], ",")
+	if len(parts) > 2 {
+		return "", bounds, false
+	}
+
+	for index, part := range parts {
+		bound, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return "", bounds, false
+		}
+
+		bounds[index] = bound
+	}
+
+	if len(parts) == 1 {
+		bounds[0], bounds[1] = 0, bounds[0]
+	}
+
+	if bounds[0] >= bounds[1] {
+		return "", bounds, false
+	}
+
+	return name[:start], bounds, true
+}
+
+const (
+	// RandomSourceName is the name of the RandomSource.
+	RandomSourceName = "random"
+	// RandomSeedProperty is the name of the property which holds the seed of the RandomSource of the application,
+	// so that the random values are reproducible, such as in the tests.
+	RandomSeedProperty = "procyon.random.seed"
+)
+
+// RandomSource struct represents a source of random properties such as 'random.int', 'random.int(10,20)',
+// 'random.long', 'random.uuid' and 'random.value'. A new random value is generated on each lookup.
+// It is intended to be added to the end of the sources, so that it has the lowest precedence.
+type RandomSource struct {
+	generator *randomGenerator
+}
+
+// NewRandomSource function creates a new RandomSource with a random seed.
+func NewRandomSource() *RandomSource {
+	return NewRandomSourceWithSeed(rand.Uint64())
+}
+
+// NewRandomSourceWithSeed function creates a new RandomSource with the given seed,
+// so that the same values are generated for the same seed.
+func NewRandomSourceWithSeed(seed uint64) *RandomSource {
+	return &RandomSource{
+		generator: newRandomGenerator(seed),
+	}
+}
+
+// Name method returns the name of the source.
+func (s *RandomSource) Name() string {
+	return RandomSourceName
+}
+
+// Source method returns the random number generator of the source.
+func (s *RandomSource) Source() any {
+	return s.generator.random
+}
+
+// ContainsProperty method checks if the given property name is a supported random property name.
+// It does not generate a random value, so that the sequence of a seeded source is not affected.
+func (s *RandomSource) ContainsProperty(name string) bool {
+	randomName, ok := strings.CutPrefix(name, RandomPlaceholderPrefix)
+	if !ok {
+		return false
+	}
+
+	return s.generator.supports(randomName)
+}
+
+// Property method returns a random value for the given property name.
+// If the property name is not a supported random property name, it returns false.
+func (s *RandomSource) Property(name string) (any, bool) {
+	randomName, ok := strings.CutPrefix(name, RandomPlaceholderPrefix)
+	if !ok {
+		return nil, false
+	}
+
+	return s.generator.generate(randomName)
+}
+
+// PropertyOrDefault method returns a random value for the given property name.
+// If the property name is not a supported random property name, it returns the default value.
+func (s *RandomSource) PropertyOrDefault(name string, defaultValue any) any {
+	value, ok := s.Property(name)
+	if !ok {
+		return defaultValue
+	}
+
+	return value
+}
+
+// PropertyNames method returns no property names since the random properties are generated on demand.
+func (s *RandomSource) PropertyNames() []string {
+	return []string{}
+}
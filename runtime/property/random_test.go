@@ -0,0 +1,98 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRandomSource_PropertyShouldReturnRandomValues(t *testing.T) {
+	source := NewRandomSource()
+
+	value, ok := source.Property("random.int")
+	assert.True(t, ok)
+	assert.IsType(t, 0, value)
+
+	value, ok = source.Property("random.long")
+	assert.True(t, ok)
+	assert.IsType(t, int64(0), value)
+
+	value, ok = source.Property("random.value")
+	assert.True(t, ok)
+	assert.Len(t, value, 32)
+
+	value, ok = source.Property("random.uuid")
+	assert.True(t, ok)
+	assert.Len(t, value, 36)
+
+	for i := 0; i < 100; i++ {
+		value, ok = source.Property("random.int(10,20)")
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, value, 10)
+		assert.Less(t, value, 20)
+
+		value, ok = source.Property("random.long[5]")
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, value, int64(0))
+		assert.Less(t, value, int64(5))
+	}
+}
+
+func TestRandomSource_PropertyShouldReturnFalseForUnsupportedNames(t *testing.T) {
+	source := NewRandomSource()
+
+	for _, name := range []string{"random.any", "random.int(20,10)", "random.int(1,2", "random.int(a,b)", "any.int"} {
+		_, ok := source.Property(name)
+		assert.False(t, ok, name)
+		assert.False(t, source.ContainsProperty(name), name)
+	}
+}
+
+func TestRandomSource_PropertyShouldReturnSameValuesForSameSeed(t *testing.T) {
+	source := NewRandomSourceWithSeed(42)
+	anotherSource := NewRandomSourceWithSeed(42)
+
+	for _, name := range []string{"random.int", "random.long", "random.uuid", "random.value", "random.int(0,1000)"} {
+		value, _ := source.Property(name)
+		anotherValue, _ := anotherSource.Property(name)
+		assert.Equal(t, value, anotherValue)
+	}
+}
+
+func TestRandomSource_ContainsPropertyShouldNotAffectSequenceOfSeededSource(t *testing.T) {
+	source := NewRandomSourceWithSeed(42)
+	anotherSource := NewRandomSourceWithSeed(42)
+
+	for _, name := range []string{"random.int", "random.long", "random.uuid", "random.value", "random.int(0,1000)"} {
+		assert.True(t, source.ContainsProperty(name), name)
+
+		value, _ := source.Property(name)
+		anotherValue, _ := anotherSource.Property(name)
+		assert.Equal(t, anotherValue, value, name)
+	}
+}
+
+func TestSourcesResolver_ShouldDrawSingleRandomValueForEachLookup(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewRandomSourceWithSeed(42))
+	resolver := NewSourcesResolver(sources)
+
+	expectedSource := NewRandomSourceWithSeed(42)
+	first, _ := expectedSource.Property("random.long")
+	second, _ := expectedSource.Property("random.long")
+	third, _ := expectedSource.Property("random.long")
+
+	assert.True(t, resolver.ContainsProperty("random.long"))
+
+	value, err := resolver.RequiredProperty("random.long")
+	assert.Nil(t, err)
+	assert.Equal(t, first, value)
+
+	value, origin, ok := resolver.PropertyWithOrigin("random.long")
+	assert.True(t, ok)
+	assert.Equal(t, second, value)
+	assert.Equal(t, RandomSourceName, origin.Source)
+
+	value, ok = resolver.Property("random.long")
+	assert.True(t, ok)
+	assert.Equal(t, third, value)
+}
@@ -1,7 +1,11 @@
 package property
 
 import (
+	"codnect.io/procyon-core/runtime/conversion"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 )
 
 // Resolver interface provides methods for resolving properties.
@@ -13,30 +17,175 @@ type Resolver interface {
 	ResolveRequiredPlaceholders(text string) (string, error)
 }
 
-// SourcesResolver is an implementation of the Resolver interface.
-// It resolves properties from the given sources.
+// ConfigurableResolver interface is a Resolver which also provides the raw values, the origins and the required
+// values of the properties and the conversion service used for converting them, and accepts placeholder resolvers.
+type ConfigurableResolver interface {
+	Resolver
+	RawProperty(name string) (any, bool)
+	PropertyWithOrigin(name string) (any, Origin, bool)
+	RequiredProperty(name string) (any, error)
+	ConversionService() conversion.Service
+	AddPlaceholderResolvers(resolvers ...PlaceholderResolver)
+}
+
+// defaultConversionService returns the conversion service used for the resolvers which are not ConfigurableResolver.
+var defaultConversionService = sync.OnceValue(func() conversion.Service {
+	return conversion.NewDefaultService()
+})
+
+// ConversionServiceOf function returns the conversion service of the given resolver if it is a ConfigurableResolver,
+// otherwise it returns a default conversion service.
+func ConversionServiceOf(resolver Resolver) conversion.Service {
+	if configurable, ok := resolver.(ConfigurableResolver); ok {
+		return configurable.ConversionService()
+	}
+
+	return defaultConversionService()
+}
+
+// requiredProperty function returns the value of the property with the given name with its placeholders resolved.
+// If the given resolver is not a ConfigurableResolver, the unresolvable placeholders are left as they are.
+// It returns an error wrapping ErrPropertyNotFound if the property does not exist.
+func requiredProperty(resolver Resolver, name string) (any, error) {
+	if configurable, ok := resolver.(ConfigurableResolver); ok {
+		return configurable.RequiredProperty(name)
+	}
+
+	value, ok := resolver.Property(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrPropertyNotFound, name)
+	}
+
+	return value, nil
+}
+
+// propertyOrigin function returns the origin of the property with the given name if the given resolver
+// is a ConfigurableResolver, otherwise it returns false.
+func propertyOrigin(resolver Resolver, name string) (Origin, bool) {
+	if configurable, ok := resolver.(ConfigurableResolver); ok {
+		_, origin, exists := configurable.PropertyWithOrigin(name)
+		return origin, exists
+	}
+
+	return Origin{}, false
+}
+
+// ListLookup interface is implemented by the resolvers which can tell the number of the items of a list property,
+// so that the items of a list are not merged from different sources.
+type ListLookup interface {
+	// ListSize returns the number of the items of the list with the given name.
+	ListSize(name string) int
+}
+
+// SourcesResolver is an implementation of the ConfigurableResolver interface.
+// It resolves properties from the given sources. The placeholders in the string values
+// are resolved lazily whenever the properties are looked up.
 type SourcesResolver struct {
-	sources *Sources
+	sources              *Sources
+	conversion           conversion.Service
+	placeholders         *PlaceholderParser
+	placeholderResolvers []PlaceholderResolver
+	mu                   sync.RWMutex
 }
 
 // NewSourcesResolver creates a new SourcesResolver with the given sources.
-func NewSourcesResolver(sources *Sources) *SourcesResolver {
+// The given options configure the syntax of the placeholders resolved by the resolver.
+func NewSourcesResolver(sources *Sources, options ...PlaceholderOption) *SourcesResolver {
 	if sources == nil {
 		panic("nil sources")
 	}
 
 	return &SourcesResolver{
-		sources: sources,
+		sources:      sources,
+		conversion:   conversion.NewDefaultService(),
+		placeholders: NewPlaceholderParser(options...),
 	}
 }
 
 // ContainsProperty checks if the given property name exists in the sources.
 func (r *SourcesResolver) ContainsProperty(name string) bool {
-	return r.sources.Contains(name)
+	for _, source := range r.sources.ToSlice() {
+		if source.ContainsProperty(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsPrefix checks if there is any property under the given prefix in the sources.
+// The sources implementing the PrefixLookup interface are asked directly, the property names
+// of the other sources are checked against the prefix.
+func (r *SourcesResolver) ContainsPrefix(prefix string) bool {
+	for _, source := range r.sources.ToSlice() {
+		if sourceContainsPrefix(source, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListSize returns the number of the items of the list with the given name. The items are read from the indexed
+// properties such as 'name.0' and 'name.1' of the first source which contains the property or its first item,
+// so that a list in a source replaces the lists in the sources with lower precedence instead of being merged with them.
+func (r *SourcesResolver) ListSize(name string) int {
+	for _, source := range r.sources.ToSlice() {
+		if source.ContainsProperty(name) {
+			return 0
+		}
+
+		size := 0
+		for sourceContainsItem(source, indexedName(name, size)) {
+			size++
+		}
+
+		if size != 0 {
+			return size
+		}
+	}
+
+	return 0
+}
+
+// sourceContainsPrefix function checks if there is any property under the given prefix in the given source.
+func sourceContainsPrefix(source Source, prefix string) bool {
+	if lookup, ok := source.(PrefixLookup); ok {
+		return lookup.ContainsPrefix(prefix)
+	}
+
+	for _, name := range source.PropertyNames() {
+		if strings.HasPrefix(name, prefix+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sourceContainsItem function checks if the given source contains the list item with the given name
+// or any property under it.
+func sourceContainsItem(source Source, name string) bool {
+	return source.ContainsProperty(name) || sourceContainsPrefix(source, name)
 }
 
 // Property returns the value of the given property name from the sources.
+// The placeholders in the value are resolved, and the unresolvable ones are left as they are.
 func (r *SourcesResolver) Property(name string) (any, bool) {
+	value, ok := r.RawProperty(name)
+	if !ok {
+		return nil, false
+	}
+
+	if text, isString := value.(string); isString {
+		return r.ResolvePlaceholders(text), true
+	}
+
+	return value, true
+}
+
+// RawProperty returns the value of the given property name from the sources without resolving its placeholders.
+func (r *SourcesResolver) RawProperty(name string) (any, bool) {
 	for _, source := range r.sources.ToSlice() {
 		if value, ok := source.Property(name); ok {
 			return value, true
@@ -46,115 +195,160 @@ func (r *SourcesResolver) Property(name string) (any, bool) {
 	return nil, false
 }
 
-// PropertyOrDefault returns the value of the given property name from the sources.
-// If the property does not exist, it returns the default value.
-func (r *SourcesResolver) PropertyOrDefault(name string, defaultValue any) any {
+// PropertyWithOrigin returns the value of the given property name from the sources with its placeholders resolved,
+// and the origin of the value. If the source of the value cannot report its origin, the origin names only the source.
+func (r *SourcesResolver) PropertyWithOrigin(name string) (any, Origin, bool) {
+	source, value, ok := r.findSource(name)
+	if !ok {
+		return nil, Origin{}, false
+	}
+
+	if text, isString := value.(string); isString {
+		value = r.ResolvePlaceholders(text)
+	}
+
+	return value, OriginOf(source, name), true
+}
+
+// RequiredProperty returns the value of the given property name from the sources with its placeholders resolved.
+// It returns an error wrapping ErrPropertyNotFound if the property does not exist, or an error which names
+// the property and its origin if a placeholder in the value cannot be resolved.
+func (r *SourcesResolver) RequiredProperty(name string) (any, error) {
+	source, value, ok := r.findSource(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrPropertyNotFound, name)
+	}
+
+	text, isString := value.(string)
+	if !isString {
+		return value, nil
+	}
+
+	resolved, err := r.ResolveRequiredPlaceholders(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w in property '%s' from %s", err, name, OriginOf(source, name))
+	}
+
+	return resolved, nil
+}
+
+// findSource returns the first source which contains the given property name and the value in the source.
+// The value is returned along with the source, since the sources such as the RandomSource return
+// a different value on each lookup.
+func (r *SourcesResolver) findSource(name string) (Source, any, bool) {
 	for _, source := range r.sources.ToSlice() {
 		if value, ok := source.Property(name); ok {
-			return value.(string)
+			return source, value, true
 		}
 	}
 
-	return defaultValue
+	return nil, nil, false
+}
+
+// propertyNames returns the property names in the sources.
+func (r *SourcesResolver) propertyNames() []string {
+	names := make([]string, 0)
+
+	for _, source := range r.sources.ToSlice() {
+		names = append(names, source.PropertyNames()...)
+	}
+
+	return names
+}
+
+// PropertyOrDefault returns the value of the given property name from the sources.
+// If the default value is not nil, the value is converted to the type of the default value.
+// If the property does not exist or cannot be converted, it returns the default value.
+func (r *SourcesResolver) PropertyOrDefault(name string, defaultValue any) any {
+	value, ok := r.Property(name)
+	if !ok {
+		return defaultValue
+	}
+
+	if defaultValue == nil {
+		return value
+	}
+
+	converted, err := r.conversion.Convert(value, reflect.TypeOf(defaultValue))
+	if err != nil {
+		return defaultValue
+	}
+
+	return converted
+}
+
+// ConversionService returns the conversion service which is used for converting the property values.
+func (r *SourcesResolver) ConversionService() conversion.Service {
+	return r.conversion
 }
 
 // ResolvePlaceholders resolves placeholders in the given text.
-// If a placeholder cannot be resolved, it continues to resolve other placeholders.
+// If a placeholder cannot be resolved, it is left as it is and the other placeholders are resolved.
+// If the placeholders refer to each other circularly, the text is returned as it is.
 func (r *SourcesResolver) ResolvePlaceholders(s string) string {
-	result, _ := r.resolveRequiredPlaceHolders(s, true)
+	result, err := r.placeholders.Replace(s, r.lookupPlaceholder, true)
+	if err != nil {
+		return s
+	}
+
 	return result
 }
 
 // ResolveRequiredPlaceholders resolves placeholders in the given text.
 // If a placeholder cannot be resolved, it returns an error.
 func (r *SourcesResolver) ResolveRequiredPlaceholders(s string) (string, error) {
-	return r.resolveRequiredPlaceHolders(s, false)
-}
-
-// resolveRequiredPlaceHolders resolves placeholders in the given text.
-// If continueOnError is true, it continues to resolve placeholders even if a placeholder cannot be resolved.
-func (r *SourcesResolver) resolveRequiredPlaceHolders(s string, continueOnError bool) (string, error) {
-	var buf []byte
-
-	i := 0
-	for j := 0; j < len(s); j++ {
-		if s[j] == '$' && j+1 < len(s) {
-			if buf == nil {
-				buf = make([]byte, 0, 2*len(s))
-			}
-
-			buf = append(buf, s[i:j]...)
-			name, w := r.getPlaceholderName(s[j+1:])
-
-			if name == "" && w > 0 {
-			} else if name == "" {
-				buf = append(buf, s[j])
-			} else {
-				value, ok := r.Property(name)
-
-				if !ok && !continueOnError {
-					return "", fmt.Errorf("cannot resolve placeholder '%s'", s[j:i+w+1])
-				}
-
-				stringValue, canConvert := value.(string)
-				if !canConvert && !continueOnError {
-					return "", fmt.Errorf("string values can only be used as placeholder '%s'", s[j:i+w+1])
-				}
-
-				if continueOnError {
-					buf = append(buf, s[j:i+w+1]...)
-				} else {
-					buf = append(buf, stringValue...)
-				}
-			}
-
-			j += w
-			i = j + 1
+	return r.placeholders.Replace(s, r.lookupPlaceholder, false)
+}
+
+// AddPlaceholderResolvers adds the given placeholder resolvers, which are consulted for
+// the placeholders starting with their prefixes before the properties in the sources.
+func (r *SourcesResolver) AddPlaceholderResolvers(resolvers ...PlaceholderResolver) {
+	defer r.mu.Unlock()
+	r.mu.Lock()
+
+	for _, resolver := range resolvers {
+		if resolver == nil {
+			continue
 		}
-	}
 
-	if buf == nil {
-		return s, nil
+		r.placeholderResolvers = append(r.placeholderResolvers, resolver)
+		r.placeholders.reservePrefix(resolver.Prefix())
 	}
-
-	return string(buf) + s[i:], nil
 }
 
-func (r *SourcesResolver) getPlaceholderName(s string) (string, int) {
-	switch {
-	case s[0] == '{':
-		if len(s) > 2 && isSpecialVar(s[1]) && s[2] == '}' {
-			return s[1:2], 3
-		}
+// resolvePrefixedPlaceholder resolves the given placeholder name by the placeholder resolver of its prefix.
+// If there are more resolvers for the prefix, the one added first is used.
+func (r *SourcesResolver) resolvePrefixedPlaceholder(name string) (string, bool) {
+	defer r.mu.RUnlock()
+	r.mu.RLock()
 
-		for i := 1; i < len(s); i++ {
-			if s[i] == '}' {
-				if i == 1 {
-					return "", 2
-				}
-				return s[1:i], i + 1
-			}
+	for _, resolver := range r.placeholderResolvers {
+		if prefixed, ok := strings.CutPrefix(name, resolver.Prefix()); ok {
+			return resolver.ResolvePlaceholder(prefixed)
 		}
-		return "", 1
-	case isSpecialVar(s[0]):
-		return s[0:1], 1
 	}
 
-	var i int
-	for i = 0; i < len(s) && isAlphaNum(s[i]); i++ {
+	return "", false
+}
+
+// lookupPlaceholder returns the string form of the property with the given placeholder name.
+// The raw value is returned since the placeholders in it are resolved by the placeholder parser.
+// The placeholders starting with the prefixes of the placeholder resolvers are resolved by them,
+// and the properties in the sources are looked up if they cannot resolve the placeholders.
+func (r *SourcesResolver) lookupPlaceholder(name string) (string, bool) {
+	if value, ok := r.resolvePrefixedPlaceholder(name); ok {
+		return value, true
 	}
 
-	return s[:i], i
-}
+	value, ok := r.RawProperty(name)
+	if !ok {
+		return "", false
+	}
 
-func isSpecialVar(c uint8) bool {
-	switch c {
-	case '*', '#', '$', '@', '!', '?', '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-		return true
+	text, err := conversion.Convert[string](r.conversion, value)
+	if err != nil {
+		return "", false
 	}
-	return false
-}
 
-func isAlphaNum(c uint8) bool {
-	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+	return text, true
 }
@@ -0,0 +1,199 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSourcesResolver_PropertyOrDefaultShouldConvertValueToTypeOfDefaultValue(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon.server.port":    "9090",
+		"procyon.server.enabled": true,
+	}))
+
+	resolver := NewSourcesResolver(sources)
+
+	assert.Equal(t, 9090, resolver.PropertyOrDefault("procyon.server.port", 8080))
+	assert.Equal(t, true, resolver.PropertyOrDefault("procyon.server.enabled", nil))
+	assert.Equal(t, "anyValue", resolver.PropertyOrDefault("procyon.server.missing", "anyValue"))
+}
+
+func TestSourcesResolver_PropertyOrDefaultShouldReturnDefaultValueIfValueCannotBeConverted(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon.server.port": "anyPort",
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	assert.Equal(t, 8080, resolver.PropertyOrDefault("procyon.server.port", 8080))
+}
+
+func TestSourcesResolver_ContainsPropertyShouldCheckPropertyNamesInsteadOfSourceNames(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon.server.port": 8080,
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	assert.True(t, resolver.ContainsProperty("procyon.server.port"))
+	assert.False(t, resolver.ContainsProperty("anySource"))
+}
+
+func TestSourcesResolver_ContainsPrefixShouldCheckPropertiesUnderPrefix(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"procyon.server.port": 8080,
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	assert.True(t, resolver.ContainsPrefix("procyon.server"))
+	assert.False(t, resolver.ContainsPrefix("procyon.serv"))
+	assert.False(t, resolver.ContainsPrefix("procyon.server.port"))
+}
+
+func TestSourcesResolver_ListSizeShouldCountItemsInFirstSourceContainingList(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("prodSource", map[string]any{
+		"procyon.hosts":   []any{"x"},
+		"procyon.servers": "any,another",
+	}))
+	sources.AddLast(NewMapSource("defaultSource", map[string]any{
+		"procyon.hosts":   []any{"a", "b", "c"},
+		"procyon.servers": []any{map[string]any{"port": 8080}},
+		"procyon.ports":   []any{1, 2},
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	assert.Equal(t, 1, resolver.ListSize("procyon.hosts"))
+	assert.Equal(t, 2, resolver.ListSize("procyon.ports"))
+	assert.Equal(t, 0, resolver.ListSize("procyon.servers"))
+	assert.Equal(t, 0, resolver.ListSize("procyon.missing"))
+}
+
+func newAnyPlaceholderResolver() *SourcesResolver {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("application.yml", map[string]any{
+		"db": map[string]any{
+			"host":     "localhost",
+			"port":     5432,
+			"url":      "jdbc://${db.host}:${db.port}",
+			"username": "${db.user}",
+		},
+	}))
+
+	return NewSourcesResolver(sources)
+}
+
+func TestSourcesResolver_PropertyShouldResolvePlaceholdersInValue(t *testing.T) {
+	resolver := newAnyPlaceholderResolver()
+
+	value, ok := resolver.Property("db.url")
+	assert.True(t, ok)
+	assert.Equal(t, "jdbc://localhost:5432", value)
+
+	value, ok = resolver.Property("db.username")
+	assert.True(t, ok)
+	assert.Equal(t, "${db.user}", value)
+}
+
+func TestSourcesResolver_RawPropertyShouldNotResolvePlaceholdersInValue(t *testing.T) {
+	resolver := newAnyPlaceholderResolver()
+
+	value, ok := resolver.RawProperty("db.url")
+	assert.True(t, ok)
+	assert.Equal(t, "jdbc://${db.host}:${db.port}", value)
+}
+
+func TestSourcesResolver_RequiredPropertyShouldReturnErrorIfPlaceholderCannotBeResolved(t *testing.T) {
+	resolver := newAnyPlaceholderResolver()
+
+	value, err := resolver.RequiredProperty("db.url")
+	assert.Nil(t, err)
+	assert.Equal(t, "jdbc://localhost:5432", value)
+
+	_, err = resolver.RequiredProperty("db.username")
+	assert.EqualError(t, err, "could not resolve placeholder '${db.user}' in property 'db.username' from source 'application.yml'")
+
+	_, err = resolver.RequiredProperty("db.password")
+	assert.ErrorIs(t, err, ErrPropertyNotFound)
+}
+
+func TestSourcesResolver_PropertyWithOriginShouldReturnValueAndItsOrigin(t *testing.T) {
+	source, err := NewYamlSourceLoader().Load("application.yml", strings.NewReader("db:\n  host: localhost\n  url: jdbc://${db.host}\n"))
+	assert.Nil(t, err)
+
+	sources := NewSources()
+	sources.AddLast(source)
+	resolver := NewSourcesResolver(sources)
+
+	value, origin, ok := resolver.PropertyWithOrigin("db.url")
+	assert.True(t, ok)
+	assert.Equal(t, "jdbc://localhost", value)
+	assert.Equal(t, Origin{Source: "application.yml", Location: "application.yml", Line: 3, Column: 8}, origin)
+
+	_, _, ok = resolver.PropertyWithOrigin("db.password")
+	assert.False(t, ok)
+}
+
+func TestSourcesResolver_RequiredPropertyShouldCiteOriginIfPlaceholderCannotBeResolved(t *testing.T) {
+	source, err := NewYamlSourceLoader().Load("application.yml", strings.NewReader("db:\n  username: ${db.user}\n"))
+	assert.Nil(t, err)
+
+	sources := NewSources()
+	sources.AddLast(source)
+
+	_, err = NewSourcesResolver(sources).RequiredProperty("db.username")
+	assert.EqualError(t, err, "could not resolve placeholder '${db.user}' in property 'db.username' "+
+		"from source 'application.yml' (application.yml:2:13)")
+}
+
+func TestSourcesResolver_ResolvePlaceholdersShouldResolvePropertiesOfAnyType(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"db.host": "localhost",
+		"db.port": 5432,
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	assert.Equal(t, "localhost:5432/${db.name}", resolver.ResolvePlaceholders("${db.host}:${db.port}/${db.name}"))
+
+	_, err := resolver.ResolveRequiredPlaceholders("${db.host}:${db.port}/${db.name}")
+	assert.EqualError(t, err, "could not resolve placeholder '${db.name}'")
+}
+
+func TestSourcesResolver_ResolvePlaceholdersShouldUsePlaceholderResolversOfPrefixes(t *testing.T) {
+	t.Setenv("ANY_PLACEHOLDER_VARIABLE", "anyValue")
+
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"any.name": "${env:ANY_PLACEHOLDER_VARIABLE}-${base64:cHJvY3lvbg==}",
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	resolver.AddPlaceholderResolvers(NewEnvPlaceholderResolver(), NewBase64PlaceholderResolver(), &anyCountingPlaceholderResolver{})
+
+	value, ok := resolver.Property("any.name")
+	assert.True(t, ok)
+	assert.Equal(t, "anyValue-procyon", value)
+
+	assert.Equal(t, "anyDefault", resolver.ResolvePlaceholders("${env:MISSING_PLACEHOLDER_VARIABLE:anyDefault}"))
+	assert.Equal(t, "any", resolver.ResolvePlaceholders("${count:any}"))
+}
+
+func TestSourcesResolver_ResolvePlaceholdersShouldLookUpPropertiesIfPlaceholderResolverCannotResolveThem(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{
+		"random.thing": "anyThing",
+	}))
+
+	resolver := NewSourcesResolver(sources)
+	resolver.AddPlaceholderResolvers(NewRandomPlaceholderResolver(NewRandomSourceWithSeed(42)))
+
+	assert.Equal(t, "anyThing", resolver.ResolvePlaceholders("${random.thing}"))
+	assert.Regexp(t, "^-?[0-9]+$", resolver.ResolvePlaceholders("${random.int}"))
+
+	_, err := resolver.ResolveRequiredPlaceholders("${random.missing}")
+	assert.EqualError(t, err, "could not resolve placeholder '${random.missing}'")
+}
@@ -1,6 +1,9 @@
 package property
 
-import "sync"
+import (
+	"slices"
+	"sync"
+)
 
 // Source interface provides methods for handling property sources.
 type Source interface {
@@ -20,6 +23,13 @@ type Source interface {
 	PropertyNames() []string
 }
 
+// PrefixLookup interface is implemented by the sources whose property names are not in the dotted form,
+// such as the environment variables, so that they can report the properties under a prefix.
+type PrefixLookup interface {
+	// ContainsPrefix checks if there is any property under the given prefix in the source.
+	ContainsPrefix(prefix string) bool
+}
+
 // Sources struct is a collection of property sources.
 type Sources struct {
 	sources []Source
@@ -92,19 +102,47 @@ func (s *Sources) AddAtIndex(index int, source Source) {
 	s.mu.Lock()
 
 	s.removeIfPresent(source)
+	index = min(max(index, 0), len(s.sources))
+	s.sources = slices.Insert(s.sources, index, source)
+}
 
-	if len(s.sources) == index {
-		s.mu.Unlock()
-		s.AddLast(source)
-		return
+// AddBefore adds the source to the sources just before the source with the given name.
+// If there is no source with the given name, the source is added to the end of the sources.
+func (s *Sources) AddBefore(name string, source Source) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	s.removeIfPresent(source)
+
+	_, index := s.findPropertySourceByName(name)
+	if index == -1 {
+		index = len(s.sources)
 	}
 
-	s.sources = append(s.sources[:index+1], s.sources[index:]...)
-	s.sources[index] = source
+	s.sources = slices.Insert(s.sources, index, source)
+}
+
+// AddAfter adds the source to the sources just after the source with the given name.
+// If there is no source with the given name, the source is added to the end of the sources.
+func (s *Sources) AddAfter(name string, source Source) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	s.removeIfPresent(source)
+
+	_, index := s.findPropertySourceByName(name)
+	if index == -1 {
+		index = len(s.sources) - 1
+	}
+
+	s.sources = slices.Insert(s.sources, index+1, source)
 }
 
 // Remove removes the source with the given name from the sources.
 func (s *Sources) Remove(name string) Source {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
 	source, index := s.findPropertySourceByName(name)
 
 	if index != -1 {
@@ -117,7 +155,11 @@ func (s *Sources) Remove(name string) Source {
 }
 
 // Replace replaces a source with the given name in the sources with a new source.
+// The new source takes the precedence of the replaced one.
 func (s *Sources) Replace(name string, source Source) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
 	_, index := s.findPropertySourceByName(name)
 
 	if index != -1 {
@@ -142,6 +184,9 @@ func (s *Sources) PrecedenceOf(source Source) int {
 
 // ToSlice returns the sources as a slice.
 func (s *Sources) ToSlice() []Source {
+	defer s.mu.RUnlock()
+	s.mu.RLock()
+
 	sources := make([]Source, len(s.sources))
 	copy(sources, s.sources)
 	return sources
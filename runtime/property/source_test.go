@@ -0,0 +1,43 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSources_AddBeforeShouldKeepRandomSourceAtLowestPrecedence(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("anySource", map[string]any{}))
+	sources.AddLast(NewRandomSourceWithSeed(42))
+	sources.AddBefore(RandomSourceName, NewMapSource("application.yml", map[string]any{
+		"random.int": 8080,
+	}))
+	sources.AddBefore("missing", NewMapSource("anotherSource", map[string]any{}))
+
+	names := make([]string, 0)
+	for _, source := range sources.ToSlice() {
+		names = append(names, source.Name())
+	}
+
+	assert.Equal(t, []string{"anySource", "application.yml", "random", "anotherSource"}, names)
+
+	value, ok := NewSourcesResolver(sources).Property("random.int")
+	assert.True(t, ok)
+	assert.Equal(t, 8080, value)
+}
+
+func TestSources_AddAfterShouldAddSourceJustAfterGivenSource(t *testing.T) {
+	sources := NewSources()
+	sources.AddLast(NewMapSource("first", map[string]any{}))
+	sources.AddLast(NewMapSource("second", map[string]any{}))
+	sources.AddAfter("first", NewMapSource("third", map[string]any{}))
+	sources.AddAfter("first", NewMapSource("fourth", map[string]any{}))
+	sources.AddAfter("missing", NewMapSource("fifth", map[string]any{}))
+
+	names := make([]string, 0)
+	for _, source := range sources.ToSlice() {
+		names = append(names, source.Name())
+	}
+
+	assert.Equal(t, []string{"first", "fourth", "third", "second", "fifth"}, names)
+}
@@ -0,0 +1,317 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Violation struct represents a constraint violation of a property.
+type Violation struct {
+	Name    string // The full name of the property.
+	Message string // The message which describes the violation.
+}
+
+// String method returns the string form of the violation.
+func (v Violation) String() string {
+	return v.Name + " " + v.Message
+}
+
+// ValidationError struct represents an error that contains all constraint violations of a property struct.
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Error method returns the error message.
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.String())
+	}
+
+	return "invalid properties: " + strings.Join(messages, ", ")
+}
+
+// Validate function validates the fields of the given property struct by their validate tags.
+// The supported rules are required, min, max, oneof and pattern, such as
+// `validate:"required,min=1,max=65535"`, `validate:"oneof=debug info"` and `validate:"pattern=^[a-z]+$"`.
+// The min and max rules check the values of numbers and durations, and the lengths of strings, slices and maps.
+// Since the rules are comma-separated, the pattern rule must be the last one and consumes the rest of the tag.
+// All violations are reported together by a ValidationError.
+func Validate(target any) error {
+	if target == nil {
+		return errors.New("nil target")
+	}
+
+	value := reflect.ValueOf(target)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return errors.New("nil target")
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("target must be a struct, but got %T", target)
+	}
+
+	violations := make([]Violation, 0)
+	err := validateStruct(Prefix(value.Type()), value, &violations)
+	if err != nil {
+		return err
+	}
+
+	if len(violations) != 0 {
+		return &ValidationError{
+			Violations: violations,
+		}
+	}
+
+	return nil
+}
+
+// validateStruct function validates the fields of the given struct value.
+func validateStruct(path string, value reflect.Value, violations *[]Violation) error {
+	typ := value.Type()
+
+	for index := 0; index < typ.NumField(); index++ {
+		field := typ.Field(index)
+
+		if !field.IsExported() || (field.Anonymous && field.Type == propertiesType) {
+			continue
+		}
+
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		fieldPath := joinPath(path, name)
+		rules, err := parseRules(fieldPath, field.Tag.Get("validate"))
+		if err != nil {
+			return err
+		}
+
+		fieldValue := value.Field(index)
+		err = validateValue(fieldPath, fieldValue, rules, violations)
+		if err != nil {
+			return err
+		}
+
+		err = validateNested(fieldPath, fieldValue, violations)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNested function validates the nested structs in the given value.
+func validateNested(path string, value reflect.Value, violations *[]Violation) error {
+	if reflect.PointerTo(value.Type()).Implements(textUnmarshalerType) {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return nil
+		}
+
+		return validateNested(path, value.Elem(), violations)
+	case reflect.Struct:
+		return validateStruct(path, value, violations)
+	case reflect.Slice:
+		for index := 0; index < value.Len(); index++ {
+			err := validateNested(indexedName(path, index), value.Index(index), violations)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			err := validateNested(joinPath(path, fmt.Sprint(key.Interface())), value.MapIndex(key), violations)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rules struct represents the parsed validation rules of a field.
+type rules struct {
+	required bool
+	min      *string
+	max      *string
+	oneOf    []string
+	pattern  *regexp.Regexp
+}
+
+// parseRules function parses the given validate tag of the property with the given name.
+func parseRules(name string, tag string) (rules, error) {
+	result := rules{}
+
+	for tag != "" {
+		var rule string
+		if strings.HasPrefix(tag, "pattern=") {
+			rule, tag = tag, ""
+		} else {
+			rule, tag, _ = strings.Cut(tag, ",")
+		}
+
+		ruleName, argument, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch ruleName {
+		case "":
+		case "required":
+			result.required = true
+		case "min":
+			result.min = &argument
+		case "max":
+			result.max = &argument
+		case "oneof":
+			result.oneOf = strings.Fields(argument)
+		case "pattern":
+			pattern, err := regexp.Compile(argument)
+			if err != nil {
+				return result, fmt.Errorf("invalid pattern for property '%s': %w", name, err)
+			}
+
+			result.pattern = pattern
+		default:
+			return result, fmt.Errorf("unknown validation rule '%s' for property '%s'", ruleName, name)
+		}
+	}
+
+	return result, nil
+}
+
+// validateValue function validates the given value by the given rules.
+func validateValue(name string, value reflect.Value, rules rules, violations *[]Violation) error {
+	addViolation := func(message string) {
+		*violations = append(*violations, Violation{
+			Name:    name,
+			Message: message,
+		})
+	}
+
+	if isEmpty(value) {
+		if rules.required {
+			addViolation("is required")
+		}
+
+		if value.Kind() == reflect.Pointer || value.Kind() == reflect.String {
+			return nil
+		}
+	}
+
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	if rules.min != nil || rules.max != nil {
+		message, err := validateRange(value, rules.min, rules.max)
+		if err != nil {
+			return fmt.Errorf("invalid range for property '%s': %w", name, err)
+		}
+
+		if message != "" {
+			addViolation(message)
+		}
+	}
+
+	if len(rules.oneOf) != 0 && !slices.Contains(rules.oneOf, fmt.Sprint(value.Interface())) {
+		addViolation(fmt.Sprintf("must be one of [%s]", strings.Join(rules.oneOf, ", ")))
+	}
+
+	if rules.pattern != nil && !rules.pattern.MatchString(fmt.Sprint(value.Interface())) {
+		addViolation(fmt.Sprintf("must match pattern '%s'", rules.pattern))
+	}
+
+	return nil
+}
+
+// validateRange function checks if the given value is in the given range.
+// It returns the violation message if the value is out of range.
+func validateRange(value reflect.Value, min *string, max *string) (string, error) {
+	var (
+		actual   float64
+		subject  = "must be"
+		minLimit float64
+		maxLimit float64
+		err      error
+	)
+
+	parseLimit := func(limit string) (float64, error) {
+		if value.Type() == durationType {
+			duration, parseErr := time.ParseDuration(limit)
+			return float64(duration), parseErr
+		}
+
+		return strconv.ParseFloat(limit, 64)
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual = float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		actual = value.Float()
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		actual = float64(value.Len())
+		subject = "length must be"
+	default:
+		return "", fmt.Errorf("min and max rules are not supported for %s", value.Type())
+	}
+
+	if min != nil {
+		minLimit, err = parseLimit(*min)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if max != nil {
+		maxLimit, err = parseLimit(*max)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case min != nil && max != nil && (actual < minLimit || actual > maxLimit):
+		return fmt.Sprintf("%s between %s and %s", subject, *min, *max), nil
+	case min != nil && max == nil && actual < minLimit:
+		return fmt.Sprintf("%s greater than or equal to %s", subject, *min), nil
+	case max != nil && min == nil && actual > maxLimit:
+		return fmt.Sprintf("%s less than or equal to %s", subject, *max), nil
+	}
+
+	return "", nil
+}
+
+// durationType is the type of time.Duration.
+var durationType = reflect.TypeFor[time.Duration]()
+
+// isEmpty function checks if the given value is empty.
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String, reflect.Array:
+		return value.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	default:
+		return value.IsZero()
+	}
+}
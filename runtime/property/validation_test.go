@@ -0,0 +1,121 @@
+package property
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type anyValidatedPoolProperties struct {
+	MaxIdle int `prop:"max-idle" validate:"min=1"`
+}
+
+type anyValidatedProperties struct {
+	Properties `prefix:"procyon.server"`
+
+	Host     string                     `prop:"host" validate:"required"`
+	Port     int                        `prop:"port" validate:"min=1,max=65535"`
+	Mode     string                     `prop:"mode" validate:"oneof=http https"`
+	Name     string                     `prop:"name" validate:"pattern=^[a-z]+(,[a-z]+)*$"`
+	Timeout  time.Duration              `prop:"timeout" validate:"max=1m"`
+	Hosts    []string                   `prop:"hosts" validate:"max=2"`
+	Pool     anyValidatedPoolProperties `prop:"pool"`
+	Replicas []anyValidatedPoolProperties
+}
+
+func TestValidate_ShouldReturnNilIfAllConstraintsAreSatisfied(t *testing.T) {
+	properties := &anyValidatedProperties{
+		Host:    "localhost",
+		Port:    8080,
+		Mode:    "https",
+		Name:    "any,name",
+		Timeout: time.Second,
+		Hosts:   []string{"anyHost"},
+		Pool: anyValidatedPoolProperties{
+			MaxIdle: 8,
+		},
+	}
+
+	err := Validate(properties)
+	assert.Nil(t, err)
+}
+
+func TestValidate_ShouldReportAllViolationsWithFullPropertyNames(t *testing.T) {
+	properties := &anyValidatedProperties{
+		Port:    70000,
+		Mode:    "ftp",
+		Name:    "Any",
+		Timeout: time.Hour,
+		Hosts:   []string{"anyHost", "anotherHost", "otherHost"},
+		Pool: anyValidatedPoolProperties{
+			MaxIdle: 0,
+		},
+		Replicas: []anyValidatedPoolProperties{{MaxIdle: 1}, {MaxIdle: -1}},
+	}
+
+	err := Validate(properties)
+	assert.Error(t, err)
+
+	validationErr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, []Violation{
+		{Name: "procyon.server.host", Message: "is required"},
+		{Name: "procyon.server.port", Message: "must be between 1 and 65535"},
+		{Name: "procyon.server.mode", Message: "must be one of [http, https]"},
+		{Name: "procyon.server.name", Message: "must match pattern '^[a-z]+(,[a-z]+)*$'"},
+		{Name: "procyon.server.timeout", Message: "must be less than or equal to 1m"},
+		{Name: "procyon.server.hosts", Message: "length must be less than or equal to 2"},
+		{Name: "procyon.server.pool.max-idle", Message: "must be greater than or equal to 1"},
+		{Name: "procyon.server.replicas.1.max-idle", Message: "must be greater than or equal to 1"},
+	}, validationErr.Violations)
+}
+
+func TestValidate_ShouldSkipOptionalRulesForEmptyStrings(t *testing.T) {
+	properties := &anyValidatedProperties{
+		Host: "localhost",
+		Port: 8080,
+		Pool: anyValidatedPoolProperties{
+			MaxIdle: 1,
+		},
+	}
+
+	err := Validate(properties)
+	assert.Nil(t, err)
+}
+
+func TestValidate_ShouldApplyRulesToZeroValues(t *testing.T) {
+	properties := &struct {
+		Properties `prefix:"procyon.server"`
+
+		Port     int           `prop:"port" validate:"min=1,max=65535"`
+		Level    int           `prop:"level" validate:"oneof=1 2 3"`
+		Timeout  time.Duration `prop:"timeout" validate:"min=1s"`
+		Hosts    []string      `prop:"hosts" validate:"min=1"`
+		MaxConns int           `prop:"max-conns" validate:"required,min=1"`
+		Mode     string        `prop:"mode" validate:"required,oneof=http https"`
+	}{}
+
+	err := Validate(properties)
+	assert.Error(t, err)
+
+	validationErr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, []Violation{
+		{Name: "procyon.server.port", Message: "must be between 1 and 65535"},
+		{Name: "procyon.server.level", Message: "must be one of [1, 2, 3]"},
+		{Name: "procyon.server.timeout", Message: "must be greater than or equal to 1s"},
+		{Name: "procyon.server.hosts", Message: "length must be greater than or equal to 1"},
+		{Name: "procyon.server.max-conns", Message: "is required"},
+		{Name: "procyon.server.max-conns", Message: "must be greater than or equal to 1"},
+		{Name: "procyon.server.mode", Message: "is required"},
+	}, validationErr.Violations)
+}
+
+func TestValidate_ShouldReturnErrorIfRuleIsUnknown(t *testing.T) {
+	properties := &struct {
+		Name string `prop:"name" validate:"unknown"`
+	}{}
+
+	err := Validate(properties)
+	assert.EqualError(t, err, "unknown validation rule 'unknown' for property 'name'")
+}
@@ -0,0 +1,121 @@
+package runtime
+
+import (
+	"codnect.io/procyon-core/runtime/property"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewApplicationJsonSource_ShouldCreateSourceFromEnvironmentVariable(t *testing.T) {
+	t.Setenv(ApplicationJsonVariable, `{"procyon": {"server": {"port": 8080}}}`)
+
+	source, err := NewApplicationJsonSource(newArguments())
+	assert.Nil(t, err)
+	assert.Equal(t, ApplicationJsonSourceName, source.Name())
+
+	value, ok := source.Property("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, 8080, value)
+}
+
+func TestNewApplicationJsonSource_ShouldPreferArgumentOverEnvironmentVariable(t *testing.T) {
+	t.Setenv(ApplicationJsonVariable, `{"procyon": {"server": {"port": 8080}}}`)
+
+	args := newArguments()
+	args.addOptionArgs(ApplicationJsonProperty, `{"procyon": {"server": {"port": 9090}}}`)
+
+	source, err := NewApplicationJsonSource(args)
+	assert.Nil(t, err)
+
+	value, ok := source.Property("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, 9090, value)
+}
+
+func TestNewApplicationJsonSource_ShouldReportOriginsOfProperties(t *testing.T) {
+	t.Setenv(ApplicationJsonVariable, `{"procyon": {"server": {"port": 8080}}}`)
+
+	source, err := NewApplicationJsonSource(newArguments())
+	assert.Nil(t, err)
+	assert.Equal(t, property.Origin{
+		Source:   ApplicationJsonSourceName,
+		Location: "environment variable 'PROCYON_APPLICATION_JSON'",
+	}, property.OriginOf(source, "procyon.server.port"))
+
+	args := newArguments()
+	args.addOptionArgs(ApplicationJsonProperty, `{"procyon": {"server": {"port": 9090}}}`)
+
+	source, err = NewApplicationJsonSource(args)
+	assert.Nil(t, err)
+	assert.Equal(t, property.Origin{
+		Source:   ApplicationJsonSourceName,
+		Location: "argument '--procyon.application.json'",
+	}, property.OriginOf(source, "procyon.server.port"))
+}
+
+func TestNewApplicationJsonSource_ShouldReturnNilIfThereIsNoInlineJson(t *testing.T) {
+	t.Setenv(ApplicationJsonVariable, "")
+
+	source, err := NewApplicationJsonSource(newArguments())
+	assert.Nil(t, err)
+	assert.Nil(t, source)
+}
+
+func TestEnvironmentSource_PropertyOriginShouldReturnEnvironmentVariable(t *testing.T) {
+	t.Setenv("PROCYON_ANY_ORIGIN_PORT", "8080")
+
+	origin := property.OriginOf(NewEnvironmentSource(), "procyon.any-origin.port")
+	assert.Equal(t, property.Origin{
+		Source:   EnvironmentSourceName,
+		Location: "environment variable 'PROCYON_ANY_ORIGIN_PORT'",
+	}, origin)
+}
+
+func TestArgumentsSource_PropertyOriginShouldReturnArgumentIndex(t *testing.T) {
+	args, err := ParseArguments([]string{"anyCommand", "--procyon.server.port=8080", "--procyon.server.port=9090"})
+	assert.Nil(t, err)
+
+	source := NewArgumentsSource(args)
+
+	origin, ok := source.PropertyOrigin("procyon.server.port")
+	assert.True(t, ok)
+	assert.Equal(t, property.Origin{
+		Source:   "commandLineArgs",
+		Location: "argument at index 1",
+	}, origin)
+
+	_, ok = source.PropertyOrigin("procyon.server.host")
+	assert.False(t, ok)
+}
+
+type anyTlsProperties struct {
+	Enabled bool `prop:"enabled"`
+}
+
+type anyServerProperties struct {
+	property.Properties `prefix:"app"`
+
+	TLS *anyTlsProperties `prop:"tls"`
+}
+
+func TestEnvironmentSource_ContainsPrefixShouldMatchVariablesInRelaxedForm(t *testing.T) {
+	t.Setenv("PROCYON_ANY_PREFIX_MAX_IDLE", "8")
+
+	source := NewEnvironmentSource()
+	assert.True(t, source.ContainsPrefix("procyon.any-prefix"))
+	assert.False(t, source.ContainsPrefix("procyon.any-prefix.max-idle"))
+	assert.False(t, source.ContainsPrefix("procyon.any-other"))
+}
+
+func TestEnvironmentSource_ShouldBindPointerFieldFromRelaxedVariable(t *testing.T) {
+	t.Setenv("APP_TLS_ENABLED", "true")
+
+	sources := property.NewSources()
+	sources.AddLast(NewEnvironmentSource())
+
+	properties := &anyServerProperties{}
+	err := property.NewBinder(property.NewSourcesResolver(sources)).Bind(properties)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &anyTlsProperties{Enabled: true}, properties.TLS)
+}
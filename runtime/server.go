@@ -19,7 +19,7 @@ type Server interface {
 type ServerProperties struct {
 	property.Properties `prefix:"procyon.server"` // The prefix for server properties.
 
-	Port int `prop:"port"` // The port the server is running on.
+	Port int `prop:"port" validate:"min=0,max=65535"` // The port the server is running on.
 }
 
 // NewServerProperties function creates a new ServerProperties.
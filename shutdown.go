@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultShutdownTimeout is the timeout used when the shutdown timeout is not configured.
+const defaultShutdownTimeout = 30 * time.Second
+
+// stoppable struct represents a started component which has to be stopped on shutdown.
+type stoppable struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// shutdownCoordinator struct keeps the started components in start order
+// and stops them in reverse order on shutdown.
+type shutdownCoordinator struct {
+	stoppables []stoppable
+	mu         sync.Mutex
+}
+
+// newShutdownCoordinator function creates a new shutdownCoordinator.
+func newShutdownCoordinator() *shutdownCoordinator {
+	return &shutdownCoordinator{
+		stoppables: make([]stoppable, 0),
+	}
+}
+
+// register method registers a started component with its stop function.
+func (s *shutdownCoordinator) register(name string, stop func(ctx context.Context) error) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	s.stoppables = append(s.stoppables, stoppable{
+		name: name,
+		stop: stop,
+	})
+}
+
+// shutdown method stops the registered components in reverse start order within the given timeout.
+// It returns an error which reports the components that failed to stop or did not stop in time.
+func (s *shutdownCoordinator) shutdown(timeout time.Duration) error {
+	s.mu.Lock()
+	stoppables := s.stoppables
+	s.stoppables = make([]stoppable, 0)
+	s.mu.Unlock()
+
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var err error
+	for index := len(stoppables) - 1; index >= 0; index-- {
+		err = errors.Join(err, s.stop(ctx, stoppables[index], timeout))
+	}
+
+	return err
+}
+
+// stop method stops the given component and waits until it is stopped or the context is done.
+func (s *shutdownCoordinator) stop(ctx context.Context, component stoppable, timeout time.Duration) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("'%s' could not be stopped within %s", component.name, timeout)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- component.stop(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to stop '%s': %w", component.name, err)
+		}
+
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("'%s' could not be stopped within %s", component.name, timeout)
+	}
+}
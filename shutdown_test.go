@@ -0,0 +1,51 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestShutdownCoordinator_ShutdownShouldStopComponentsInReverseStartOrder(t *testing.T) {
+	coordinator := newShutdownCoordinator()
+	stopped := make([]string, 0)
+
+	for _, name := range []string{"first", "second", "third"} {
+		coordinator.register(name, func(ctx context.Context) error {
+			stopped = append(stopped, name)
+			return nil
+		})
+	}
+
+	err := coordinator.shutdown(time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"third", "second", "first"}, stopped)
+	assert.Len(t, coordinator.stoppables, 0)
+}
+
+func TestShutdownCoordinator_ShutdownShouldReportComponentsFailedToStop(t *testing.T) {
+	coordinator := newShutdownCoordinator()
+	coordinator.register("anyComponent", func(ctx context.Context) error {
+		return errors.New("anyError")
+	})
+
+	err := coordinator.shutdown(time.Second)
+	assert.EqualError(t, err, "failed to stop 'anyComponent': anyError")
+}
+
+func TestShutdownCoordinator_ShutdownShouldReportComponentsNotStoppedInTime(t *testing.T) {
+	coordinator := newShutdownCoordinator()
+	coordinator.register("anyComponent", func(ctx context.Context) error {
+		return nil
+	})
+	coordinator.register("slowComponent", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	err := coordinator.shutdown(time.Millisecond * 50)
+	assert.EqualError(t, err, "'slowComponent' could not be stopped within 50ms\n"+
+		"'anyComponent' could not be stopped within 50ms")
+}